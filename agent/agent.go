@@ -0,0 +1,642 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package agent implements an agent running avalanchego processes on its
+// host for a network runner, and the node process creator that places the
+// nodes of a network on agents.
+package agent
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/ava-labs/avalanche-network-runner/pkg/grpcauth"
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanchego/config"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// File the stdout and stderr of a process are written to,
+	// in the directory of its node
+	outputFileName = "output.log"
+	// Max bytes read from the end of the output of a process by TailLogs
+	maxTailBytes = 1 << 20
+	// Lines returned by TailLogs if the request doesn't say
+	defaultTailLines = 100
+	// Flag telling a node the IP it advertises to its peers
+	publicIPKey = "public-ip"
+	// Flag telling newer nodes the dir of their VM plugins
+	pluginDirKey = "plugin-dir"
+	// Address the gRPC server listens on by default,
+	// only reachable from the host of the agent
+	DefaultPort = "127.0.0.1:9090"
+)
+
+var (
+	ErrNoBinaryDirs      = errors.New("no binary dirs given")
+	ErrBinaryNotAllowed  = errors.New("binary not in the binary dirs of the agent")
+	ErrDirNotAllowed     = errors.New("dir of binaries not in the binary dirs of the agent")
+	ErrInvalidHost       = errors.New("invalid host IP")
+	ErrInvalidName       = errors.New("invalid node name")
+	ErrInvalidFilePath   = errors.New("invalid file path")
+	ErrProcessNotFound   = errors.New("process not found")
+	ErrNodeAlreadyExists = errors.New("node already has a running process")
+)
+
+// Flags pointing a node to dirs it runs binaries from,
+// which must be in the binary dirs of the agent
+var binaryDirFlags = []string{
+	config.BuildDirKey,
+	pluginDirKey,
+}
+
+type Config struct {
+	// Address the gRPC server listens on, e.g. ":9090".
+	// Defaults to DefaultPort.
+	Port string
+	// IP the nodes started by the agent are reachable at by the
+	// runner and by the other nodes, which they advertise to their
+	// peers unless given a public IP. Defaults to 127.0.0.1.
+	Host string
+	// Directory the nodes are run in.
+	// If empty, a new temporary directory is used.
+	RootDir string
+	// Directories the binaries run by the agent must be in, as a client
+	// of the agent gives the binary path and the args of each process.
+	BinaryDirs []string
+	// If set, the gRPC server serves TLS with this cert.
+	TLSCertFile string
+	TLSKeyFile  string
+	// If set along with the cert, clients must present a cert
+	// signed by a CA of this PEM file (mTLS).
+	TLSClientCAFile string
+	// If any is non-empty, requests must carry one of these tokens
+	// in an "authorization: Bearer <token>" metadata entry.
+	AuthTokens []string
+}
+
+type Agent interface {
+	Run(rootCtx context.Context) error
+}
+
+type agent struct {
+	cfg Config
+	// [cfg.BinaryDirs], absolute and with their symlinks resolved
+	binaryDirs []string
+
+	ln         net.Listener
+	gRPCServer *grpc.Server
+
+	lock sync.Mutex
+	// Process ID --> process
+	processes map[string]*process
+	// Node name --> ID of its running process
+	running map[string]string
+	// Number of processes started, used for process IDs
+	started uint64
+
+	rpcpb.UnimplementedAgentServiceServer
+}
+
+type process struct {
+	cmd *exec.Cmd
+	// Output of the process, closed when it exits
+	output *os.File
+	// Closed when the process exits
+	doneCh chan struct{}
+	// Error returned by the wait on the process,
+	// set before [doneCh] is closed
+	waitErr error
+}
+
+// signal sends [sig] to the process group of the process,
+// so that the processes it started get it too
+func (p *process) signal(sig syscall.Signal) error {
+	if err := syscall.Kill(-p.cmd.Process.Pid, sig); err != nil {
+		if errors.Is(err, syscall.ESRCH) {
+			return os.ErrProcessDone
+		}
+		return err
+	}
+	return nil
+}
+
+func New(cfg Config) (Agent, error) {
+	if cfg.Port == "" {
+		cfg.Port = DefaultPort
+	}
+	if len(cfg.BinaryDirs) == 0 {
+		return nil, ErrNoBinaryDirs
+	}
+	binaryDirs := make([]string, 0, len(cfg.BinaryDirs))
+	for _, dir := range cfg.BinaryDirs {
+		resolvedDir, err := resolvePath(dir)
+		if err != nil {
+			return nil, fmt.Errorf("invalid binary dir %q: %w", dir, err)
+		}
+		binaryDirs = append(binaryDirs, resolvedDir)
+	}
+	if cfg.Host == "" {
+		cfg.Host = "127.0.0.1"
+	}
+	if net.ParseIP(cfg.Host) == nil {
+		return nil, ErrInvalidHost
+	}
+	if cfg.RootDir == "" {
+		rootDir, err := os.MkdirTemp("", "network-runner-agent")
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootDir = rootDir
+	}
+	if err := os.MkdirAll(cfg.RootDir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	auth, err := grpcauth.NewAuthenticator(cfg.AuthTokens, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	serverTLS, err := grpcauth.ServerTLSConfig(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	var serverOpts []grpc.ServerOption
+	if auth != nil {
+		serverOpts = append(serverOpts,
+			grpc.UnaryInterceptor(auth.UnaryInterceptor),
+			grpc.StreamInterceptor(auth.StreamInterceptor),
+		)
+	}
+	if serverTLS != nil {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(serverTLS)))
+	}
+	if auth == nil && (serverTLS == nil || serverTLS.ClientCAs == nil) {
+		zap.L().Warn("agent doesn't authenticate its clients; anyone reaching its port can run the binaries of its binary dirs",
+			zap.String("port", cfg.Port),
+		)
+	}
+
+	ln, err := net.Listen("tcp", cfg.Port)
+	if err != nil {
+		return nil, err
+	}
+	return &agent{
+		cfg:        cfg,
+		binaryDirs: binaryDirs,
+		ln:         ln,
+		gRPCServer: grpc.NewServer(serverOpts...),
+		processes:  map[string]*process{},
+		running:    map[string]string{},
+	}, nil
+}
+
+func (a *agent) Run(rootCtx context.Context) (err error) {
+	rpcpb.RegisterAgentServiceServer(a.gRPCServer, a)
+
+	gRPCErrc := make(chan error)
+	go func() {
+		zap.L().Info("serving agent gRPC server",
+			zap.String("port", a.cfg.Port),
+			zap.String("host", a.cfg.Host),
+			zap.String("root-dir", a.cfg.RootDir),
+		)
+		gRPCErrc <- a.gRPCServer.Serve(a.ln)
+	}()
+
+	select {
+	case <-rootCtx.Done():
+		zap.L().Warn("root context is done")
+		a.gRPCServer.Stop()
+		zap.L().Warn("closed agent gRPC server")
+		<-gRPCErrc
+	case err = <-gRPCErrc:
+		zap.L().Warn("agent gRPC server failed", zap.Error(err))
+	}
+
+	a.stopAll()
+	return err
+}
+
+// stopAll sends a SIGTERM to the running processes and waits for them to exit
+func (a *agent) stopAll() {
+	a.lock.Lock()
+	processes := make([]*process, 0, len(a.running))
+	for _, processID := range a.running {
+		processes = append(processes, a.processes[processID])
+	}
+	a.lock.Unlock()
+
+	for _, p := range processes {
+		_ = p.signal(syscall.SIGCONT)
+		_ = p.signal(syscall.SIGTERM)
+	}
+	for _, p := range processes {
+		<-p.doneCh
+	}
+}
+
+func (a *agent) StartProcess(ctx context.Context, req *rpcpb.StartProcessRequest) (*rpcpb.StartProcessResponse, error) {
+	zap.L().Info("received start process request",
+		zap.String("name", req.Name),
+		zap.String("binary-path", req.BinaryPath),
+	)
+	if req.Name == "" || req.Name != filepath.Base(req.Name) || req.Name == ".." {
+		return nil, ErrInvalidName
+	}
+	binaryPath, err := a.checkBinaryPath(req.BinaryPath)
+	if err != nil {
+		return nil, err
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if _, ok := a.running[req.Name]; ok {
+		return nil, ErrNodeAlreadyExists
+	}
+
+	dir := filepath.Join(a.cfg.RootDir, req.Name)
+	if err := writeFiles(dir, req.Files); err != nil {
+		return nil, err
+	}
+	if err := a.checkBinaryDirFlags(dir, req.Args); err != nil {
+		return nil, err
+	}
+	output, err := os.OpenFile(filepath.Join(dir, outputFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	args := req.Args
+	if !hasFlag(args, publicIPKey) {
+		args = append(args, fmt.Sprintf("--%s=%s", publicIPKey, a.cfg.Host))
+	}
+	cmd := exec.Command(binaryPath, args...)
+	cmd.Dir = dir
+	cmd.Stdout = output
+	cmd.Stderr = output
+	// Signals are sent to the process group of the node,
+	// which includes the processes it starts, e.g. VM plugins
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		_ = output.Close()
+		return nil, err
+	}
+
+	a.started++
+	processID := fmt.Sprintf("%s-%d", req.Name, a.started)
+	p := &process{
+		cmd:    cmd,
+		output: output,
+		doneCh: make(chan struct{}),
+	}
+	a.processes[processID] = p
+	a.running[req.Name] = processID
+	go a.wait(req.Name, processID, p)
+
+	return &rpcpb.StartProcessResponse{
+		ProcessId: processID,
+		Dir:       dir,
+		Host:      a.cfg.Host,
+	}, nil
+}
+
+// wait waits for [p] to exit, and records it
+func (a *agent) wait(name string, processID string, p *process) {
+	err := p.cmd.Wait()
+	_ = p.output.Close()
+	zap.L().Info("process exited",
+		zap.String("process-id", processID),
+		zap.Error(err),
+	)
+
+	a.lock.Lock()
+	if a.running[name] == processID {
+		delete(a.running, name)
+	}
+	a.lock.Unlock()
+
+	p.waitErr = err
+	close(p.doneCh)
+}
+
+func (a *agent) StopProcess(ctx context.Context, req *rpcpb.StopProcessRequest) (*rpcpb.StopProcessResponse, error) {
+	zap.L().Info("received stop process request", zap.String("process-id", req.ProcessId))
+	if err := a.signal(req.ProcessId, syscall.SIGTERM); err != nil {
+		return nil, err
+	}
+	return &rpcpb.StopProcessResponse{}, nil
+}
+
+func (a *agent) KillProcess(ctx context.Context, req *rpcpb.KillProcessRequest) (*rpcpb.KillProcessResponse, error) {
+	zap.L().Info("received kill process request", zap.String("process-id", req.ProcessId))
+	if err := a.signal(req.ProcessId, syscall.SIGKILL); err != nil {
+		return nil, err
+	}
+	return &rpcpb.KillProcessResponse{}, nil
+}
+
+func (a *agent) PauseProcess(ctx context.Context, req *rpcpb.PauseProcessRequest) (*rpcpb.PauseProcessResponse, error) {
+	zap.L().Info("received pause process request", zap.String("process-id", req.ProcessId))
+	if err := a.signal(req.ProcessId, syscall.SIGSTOP); err != nil {
+		return nil, err
+	}
+	return &rpcpb.PauseProcessResponse{}, nil
+}
+
+func (a *agent) ResumeProcess(ctx context.Context, req *rpcpb.ResumeProcessRequest) (*rpcpb.ResumeProcessResponse, error) {
+	zap.L().Info("received resume process request", zap.String("process-id", req.ProcessId))
+	if err := a.signal(req.ProcessId, syscall.SIGCONT); err != nil {
+		return nil, err
+	}
+	return &rpcpb.ResumeProcessResponse{}, nil
+}
+
+// signal sends [sig] to process [processID].
+// A process that already exited is ignored.
+func (a *agent) signal(processID string, sig syscall.Signal) error {
+	p, err := a.getProcess(processID)
+	if err != nil {
+		return err
+	}
+	select {
+	case <-p.doneCh:
+		return nil
+	default:
+	}
+	if err := p.signal(sig); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return err
+	}
+	return nil
+}
+
+func (a *agent) WaitProcess(ctx context.Context, req *rpcpb.WaitProcessRequest) (*rpcpb.WaitProcessResponse, error) {
+	zap.L().Debug("received wait process request", zap.String("process-id", req.ProcessId))
+	p, err := a.getProcess(req.ProcessId)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case <-p.doneCh:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	resp := &rpcpb.WaitProcessResponse{}
+	if p.waitErr == nil {
+		return resp, nil
+	}
+	resp.Error = p.waitErr.Error()
+	resp.ExitCode = -1
+	var exitErr *exec.ExitError
+	if errors.As(p.waitErr, &exitErr) {
+		resp.ExitCode = int32(exitErr.ExitCode())
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+			resp.ExitSignal = status.Signal().String()
+		}
+	}
+	return resp, nil
+}
+
+func (a *agent) TailLogs(ctx context.Context, req *rpcpb.TailLogsRequest) (*rpcpb.TailLogsResponse, error) {
+	zap.L().Debug("received tail logs request", zap.String("process-id", req.ProcessId))
+	p, err := a.getProcess(req.ProcessId)
+	if err != nil {
+		return nil, err
+	}
+	numLines := int(req.Lines)
+	if numLines == 0 {
+		numLines = defaultTailLines
+	}
+	lines, err := tailFile(filepath.Join(p.cmd.Dir, outputFileName), numLines)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.TailLogsResponse{Lines: lines}, nil
+}
+
+func (a *agent) getProcess(processID string) (*process, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	p, ok := a.processes[processID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrProcessNotFound, processID)
+	}
+	return p, nil
+}
+
+// checkBinaryPath returns [binaryPath], with its symlinks resolved,
+// if it's an absolute path to a file in one of the binary dirs
+func (a *agent) checkBinaryPath(binaryPath string) (string, error) {
+	if !filepath.IsAbs(binaryPath) {
+		return "", fmt.Errorf("%w: %q isn't absolute", ErrBinaryNotAllowed, binaryPath)
+	}
+	resolvedPath, err := resolvePath(binaryPath)
+	if err != nil {
+		return "", err
+	}
+	for _, dir := range a.binaryDirs {
+		relPath, err := filepath.Rel(dir, resolvedPath)
+		if err != nil {
+			continue
+		}
+		if relPath != "." && relPath != ".." && !strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+			return resolvedPath, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrBinaryNotAllowed, binaryPath)
+}
+
+// checkBinaryDirFlags checks that the dirs [args] point the node to
+// with [binaryDirFlags], in the flags or in its config, are in the
+// binary dirs. Relative paths are relative to [dir], the node's dir.
+// The config must be JSON, so that the dirs it gives can be checked.
+func (a *agent) checkBinaryDirFlags(dir string, args []string) error {
+	var dirs []string
+	for _, flagName := range binaryDirFlags {
+		dirs = append(dirs, flagValues(args, flagName)...)
+	}
+	var configs [][]byte
+	for _, configFile := range flagValues(args, config.ConfigFileKey) {
+		configPath := os.ExpandEnv(configFile)
+		if !filepath.IsAbs(configPath) {
+			configPath = filepath.Join(dir, configPath)
+		}
+		b, err := os.ReadFile(configPath)
+		if err != nil {
+			return fmt.Errorf("couldn't read config file %q: %w", configFile, err)
+		}
+		configs = append(configs, b)
+	}
+	for _, configContent := range flagValues(args, config.ConfigContentKey) {
+		b, err := base64.StdEncoding.DecodeString(configContent)
+		if err != nil {
+			return fmt.Errorf("couldn't decode config content: %w", err)
+		}
+		configs = append(configs, b)
+	}
+	for _, b := range configs {
+		nodeConfig := map[string]interface{}{}
+		if err := json.Unmarshal(b, &nodeConfig); err != nil {
+			return fmt.Errorf("%w: config isn't JSON: %s", ErrDirNotAllowed, err)
+		}
+		for key, value := range nodeConfig {
+			for _, flagName := range binaryDirFlags {
+				if !strings.EqualFold(key, flagName) {
+					continue
+				}
+				dirValue, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("%w: %s in config isn't a string", ErrDirNotAllowed, key)
+				}
+				dirs = append(dirs, dirValue)
+			}
+		}
+	}
+
+	for _, binaryDir := range dirs {
+		path := os.ExpandEnv(binaryDir)
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		resolvedPath, err := resolvePath(path)
+		if err != nil {
+			return fmt.Errorf("%w: %q: %s", ErrDirNotAllowed, binaryDir, err)
+		}
+		if !a.inBinaryDirs(resolvedPath) {
+			return fmt.Errorf("%w: %q", ErrDirNotAllowed, binaryDir)
+		}
+	}
+	return nil
+}
+
+// inBinaryDirs returns true if [resolvedPath] is
+// one of the binary dirs, or a path under one of them
+func (a *agent) inBinaryDirs(resolvedPath string) bool {
+	for _, dir := range a.binaryDirs {
+		relPath, err := filepath.Rel(dir, resolvedPath)
+		if err != nil {
+			continue
+		}
+		if relPath != ".." && !strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePath returns [path] made absolute, with its symlinks resolved
+func resolvePath(path string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.EvalSymlinks(absPath)
+}
+
+// hasFlag returns true if [args] give flag [flagName]
+func hasFlag(args []string, flagName string) bool {
+	for _, arg := range args {
+		if name, _, ok := parseFlag(arg); ok && name == flagName {
+			return true
+		}
+	}
+	return false
+}
+
+// flagValues returns the values [args] give flag [flagName], either as
+// "--name=value" or as "--name value", with one or two leading dashes
+func flagValues(args []string, flagName string) []string {
+	var values []string
+	for i, arg := range args {
+		name := strings.TrimPrefix(strings.TrimPrefix(arg, "-"), "-")
+		if name == arg {
+			continue
+		}
+		if name == flagName && i+1 < len(args) {
+			values = append(values, args[i+1])
+			continue
+		}
+		if value := strings.TrimPrefix(name, flagName+"="); value != name {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// writeFiles writes [files], by path relative to [dir], in [dir]
+func writeFiles(dir string, files map[string][]byte) error {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	for relPath, contents := range files {
+		cleanPath := filepath.Clean(relPath)
+		if filepath.IsAbs(cleanPath) || cleanPath == ".." || strings.HasPrefix(cleanPath, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("%w: %q", ErrInvalidFilePath, relPath)
+		}
+		path := filepath.Join(dir, cleanPath)
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, contents, 0o600); err != nil {
+			return fmt.Errorf("couldn't write file at %q: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// tailFile returns the last [numLines] lines of the file at [path],
+// reading at most its last [maxTailBytes] bytes
+func tailFile(path string, numLines int) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	offset := info.Size() - maxTailBytes
+	if offset < 0 {
+		offset = 0
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	b, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	b = bytes.TrimSuffix(b, []byte("\n"))
+	if len(b) == 0 {
+		return nil, nil
+	}
+	lines := strings.Split(string(b), "\n")
+	if offset > 0 {
+		// The first line is likely cut
+		lines = lines[1:]
+	}
+	if len(lines) > numLines {
+		lines = lines[len(lines)-numLines:]
+	}
+	return lines, nil
+}
@@ -0,0 +1,320 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package agent
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/pkg/grpcauth"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Stands in for avalanchego: prints its args and the staking key it's
+// given, then sleeps until it gets a SIGTERM, and exits cleanly.
+// Exits with the code given by --exit-code, or is killed by the
+// SIGTERM if given --kill-on-term.
+const testBinary = `#!/bin/sh
+trap 'kill $pid 2>/dev/null; exit 0' TERM
+echo "args: $@"
+for arg in "$@"; do
+	case "$arg" in
+	--staking-tls-key-file=*) echo "key: $(cat "${arg#*=}")" ;;
+	--exit-code=*) exit "${arg#*=}" ;;
+	--kill-on-term) exec sleep 60 ;;
+	esac
+done
+sleep 60 &
+pid=$!
+wait $pid
+`
+
+// Token the test agents require
+const testAuthToken = "token"
+
+// startTestAgent runs an agent on a free port of localhost, in a new
+// directory, running the binaries of [binaryDir], and returns its
+// endpoint and root dir
+func startTestAgent(t *testing.T, host string, binaryDir string) (string, string) {
+	rootDir := t.TempDir()
+	a, err := New(Config{
+		Port:       "127.0.0.1:0",
+		Host:       host,
+		RootDir:    rootDir,
+		BinaryDirs: []string{binaryDir},
+		AuthTokens: []string{testAuthToken},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error)
+	go func() {
+		errc <- a.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-errc
+	})
+	return a.(*agent).ln.Addr().String(), rootDir
+}
+
+func writeTestBinary(t *testing.T) string {
+	binaryPath := filepath.Join(t.TempDir(), "avalanchego")
+	if err := os.WriteFile(binaryPath, []byte(testBinary), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	return binaryPath
+}
+
+func TestNodeProcessCreator(t *testing.T) {
+	assert := assert.New(t)
+
+	binaryPath := writeTestBinary(t)
+	endpoint0, rootDir0 := startTestAgent(t, "127.0.0.1", filepath.Dir(binaryPath))
+	endpoint1, rootDir1 := startTestAgent(t, "127.0.0.2", filepath.Dir(binaryPath))
+
+	// Files written by the runner
+	runnerDir := t.TempDir()
+	keyPath := filepath.Join(runnerDir, "staking.key")
+	assert.NoError(os.WriteFile(keyPath, []byte("key0"), 0o600))
+	chainConfigDir := filepath.Join(runnerDir, "chainConfigs")
+	assert.NoError(os.MkdirAll(filepath.Join(chainConfigDir, "C"), os.ModePerm))
+	assert.NoError(os.WriteFile(filepath.Join(chainConfigDir, "C", "config.json"), []byte("{}"), 0o600))
+
+	creator, err := NewNodeProcessCreator([]string{endpoint0, endpoint1}, 5*time.Second, grpcauth.ClientConfig{AuthToken: testAuthToken})
+	assert.NoError(err)
+	defer creator.Close()
+
+	newProcess := func(name string, args ...string) local.RemoteNodeProcess {
+		process, err := creator.NewNodeProcess(node.Config{Name: name, BinaryPath: binaryPath}, args...)
+		assert.NoError(err)
+		assert.NoError(process.Start())
+		return process.(local.RemoteNodeProcess)
+	}
+	tailLogs := func(name string) string {
+		lines, err := creator.TailLogs(context.Background(), name, 10)
+		assert.NoError(err)
+		return strings.Join(lines, "\n")
+	}
+
+	// Nodes are placed on the agents in turn
+	process0 := newProcess(
+		"node0",
+		fmt.Sprintf("--%s=%s", config.StakingKeyPathKey, keyPath),
+		fmt.Sprintf("--%s=%s", config.ChainConfigDirKey, chainConfigDir),
+		fmt.Sprintf("--%s=%s", config.DBPathKey, filepath.Join(runnerDir, "db")),
+		"--kill-on-term",
+	)
+	process1 := newProcess("node1", "--exit-code=3")
+	agent0, ok := creator.GetAgent("node0")
+	assert.True(ok)
+	assert.Equal(endpoint0, agent0)
+	agent1, ok := creator.GetAgent("node1")
+	assert.True(ok)
+	assert.Equal(endpoint1, agent1)
+	assert.Equal("127.0.0.1", process0.GetHost())
+	assert.Equal("127.0.0.2", process1.GetHost())
+
+	// The files of the node are sent to its agent,
+	// and its args point to them there
+	assert.Eventually(func() bool {
+		return strings.Contains(tailLogs("node0"), "key: key0")
+	}, 5*time.Second, 50*time.Millisecond)
+	logs := tailLogs("node0")
+	assert.Contains(logs, fmt.Sprintf("--%s=staking.key", config.StakingKeyPathKey))
+	assert.Contains(logs, fmt.Sprintf("--%s=chain-configs", config.ChainConfigDirKey))
+	assert.Contains(logs, fmt.Sprintf("--%s=db", config.DBPathKey))
+	assert.Contains(logs, "--public-ip=127.0.0.1")
+	contents, err := os.ReadFile(filepath.Join(rootDir0, "node0", "chain-configs", "C", "config.json"))
+	assert.NoError(err)
+	assert.Equal("{}", string(contents))
+	_, err = os.Stat(filepath.Join(rootDir1, "node0"))
+	assert.True(os.IsNotExist(err))
+
+	// Exit codes and signals are reported by Wait
+	err = process1.Wait()
+	var exitErr *local.ExitError
+	assert.ErrorAs(err, &exitErr)
+	assert.Equal(3, exitErr.ExitCode)
+	assert.NoError(process1.Stop())
+
+	// A node is restarted on the same agent, in the same directory
+	process1 = newProcess("node1")
+	assert.Equal("127.0.0.2", process1.GetHost())
+	assert.Eventually(func() bool {
+		return strings.Contains(tailLogs("node1"), "--public-ip=127.0.0.2")
+	}, 5*time.Second, 50*time.Millisecond)
+	// Both runs are in the output
+	assert.Equal(2, strings.Count(tailLogs("node1"), "args:"))
+
+	assert.NoError(process0.Pause())
+	assert.NoError(process0.Resume())
+	assert.NoError(process0.Stop())
+	err = process0.Wait()
+	assert.ErrorAs(err, &exitErr)
+	assert.Equal("terminated", exitErr.ExitSignal)
+	assert.NoError(process1.Stop())
+	assert.NoError(process1.Wait())
+}
+
+func TestNetwork(t *testing.T) {
+	assert := assert.New(t)
+
+	binaryPath := writeTestBinary(t)
+	endpoint0, rootDir0 := startTestAgent(t, "127.0.0.1", filepath.Dir(binaryPath))
+	endpoint1, rootDir1 := startTestAgent(t, "127.0.0.2", filepath.Dir(binaryPath))
+	networkConfig := local.NewDefaultConfig(binaryPath)
+	auth := grpcauth.ClientConfig{AuthToken: testAuthToken}
+
+	networkConfig.P2PProxy = true
+	_, err := NewNetwork(logging.NoLog{}, networkConfig, []string{endpoint0, endpoint1}, 5*time.Second, auth, t.TempDir(), "", nil)
+	assert.ErrorIs(err, ErrP2PProxy)
+
+	networkConfig.P2PProxy = false
+	networkConfig.DistinctNodeIPs = true
+	_, err = NewNetwork(logging.NoLog{}, networkConfig, []string{endpoint0, endpoint1}, 5*time.Second, auth, t.TempDir(), "", nil)
+	assert.ErrorIs(err, ErrDistinctNodeIPs)
+
+	networkConfig.DistinctNodeIPs = false
+	nw, err := NewNetwork(logging.NoLog{}, networkConfig, []string{endpoint0, endpoint1}, 5*time.Second, auth, t.TempDir(), "", nil)
+	assert.NoError(err)
+	nodes, err := nw.GetAllNodes()
+	assert.NoError(err)
+	assert.Len(nodes, len(networkConfig.NodeConfigs))
+	hosts := map[string]int{}
+	for name, node := range nodes {
+		hosts[node.GetURL()]++
+		rootDir := rootDir0
+		if node.GetURL() == "127.0.0.2" {
+			rootDir = rootDir1
+		}
+		_, err := os.Stat(filepath.Join(rootDir, name, "staking.key"))
+		assert.NoError(err)
+		// The node handles SIGTERM once it printed its args
+		assert.Eventually(func() bool {
+			output, _ := os.ReadFile(filepath.Join(rootDir, name, outputFileName))
+			return strings.Contains(string(output), "args:")
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+	assert.Equal(map[string]int{"127.0.0.1": 3, "127.0.0.2": 2}, hosts)
+	assert.NoError(nw.Stop(context.Background()))
+}
+
+// TestAgentAuth checks that the agent only runs the binaries
+// of its binary dirs, for the clients with a token
+func TestAgentAuth(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := New(Config{RootDir: t.TempDir()})
+	assert.ErrorIs(err, ErrNoBinaryDirs)
+	_, err = New(Config{RootDir: t.TempDir(), BinaryDirs: []string{filepath.Join(t.TempDir(), "nope")}})
+	assert.Error(err)
+
+	binaryPath := writeTestBinary(t)
+	endpoint, _ := startTestAgent(t, "127.0.0.1", filepath.Dir(binaryPath))
+	start := func(token string, binaryPath string) error {
+		creator, err := NewNodeProcessCreator([]string{endpoint}, 5*time.Second, grpcauth.ClientConfig{AuthToken: token})
+		assert.NoError(err)
+		defer creator.Close()
+		process, err := creator.NewNodeProcess(node.Config{Name: "node0", BinaryPath: binaryPath})
+		assert.NoError(err)
+		if err := process.Start(); err != nil {
+			return err
+		}
+		assert.NoError(process.Kill())
+		return process.Wait()
+	}
+
+	err = start("", binaryPath)
+	assert.Equal(codes.Unauthenticated, status.Code(err))
+	err = start("nope", binaryPath)
+	assert.Equal(codes.Unauthenticated, status.Code(err))
+
+	// Binaries out of the binary dirs, including through
+	// symlinks in them, aren't run
+	binaryDir := filepath.Dir(binaryPath)
+	symlinkPath := filepath.Join(binaryDir, "sh")
+	assert.NoError(os.Symlink("/bin/sh", symlinkPath))
+	outsidePath := filepath.Join(filepath.Dir(binaryDir), "avalanchego")
+	assert.NoError(os.WriteFile(outsidePath, []byte(testBinary), 0o700))
+	for _, path := range []string{"/bin/sh", symlinkPath, binaryDir + "/../avalanchego", "avalanchego"} {
+		err = start(testAuthToken, path)
+		assert.Error(err, path)
+		assert.Contains(status.Convert(err).Message(), ErrBinaryNotAllowed.Error(), path)
+	}
+
+	err = start(testAuthToken, binaryPath)
+	var exitErr *local.ExitError
+	assert.ErrorAs(err, &exitErr)
+	assert.Equal("killed", exitErr.ExitSignal)
+}
+
+func TestBinaryDirFlags(t *testing.T) {
+	assert := assert.New(t)
+
+	binaryPath := writeTestBinary(t)
+	binaryDir := filepath.Dir(binaryPath)
+	pluginDir := filepath.Join(binaryDir, "plugins")
+	assert.NoError(os.Mkdir(pluginDir, 0o700))
+	outsideDir := t.TempDir()
+	symlinkPath := filepath.Join(binaryDir, "outside")
+	assert.NoError(os.Symlink(outsideDir, symlinkPath))
+	writeConfig := func(contents string) string {
+		configPath := filepath.Join(t.TempDir(), "config.json")
+		assert.NoError(os.WriteFile(configPath, []byte(contents), 0o600))
+		return configPath
+	}
+
+	endpoint, _ := startTestAgent(t, "127.0.0.1", binaryDir)
+	creator, err := NewNodeProcessCreator([]string{endpoint}, 5*time.Second, grpcauth.ClientConfig{AuthToken: testAuthToken})
+	assert.NoError(err)
+	defer creator.Close()
+	start := func(args ...string) error {
+		process, err := creator.NewNodeProcess(node.Config{Name: "node0", BinaryPath: binaryPath}, args...)
+		assert.NoError(err)
+		if err := process.Start(); err != nil {
+			return err
+		}
+		assert.NoError(process.Kill())
+		_ = process.Wait()
+		return nil
+	}
+
+	// Dirs of binaries out of the binary dirs, given in any form,
+	// including through symlinks in them, aren't allowed
+	for _, args := range [][]string{
+		{fmt.Sprintf("--%s=%s", config.BuildDirKey, outsideDir)},
+		{"--" + pluginDirKey, outsideDir},
+		{fmt.Sprintf("-%s=%s", pluginDirKey, symlinkPath)},
+		{fmt.Sprintf("--%s=%s/..", pluginDirKey, binaryDir)},
+		{fmt.Sprintf("--%s=%s", config.ConfigFileKey, writeConfig(fmt.Sprintf(`{"%s":%q}`, pluginDirKey, outsideDir)))},
+		{fmt.Sprintf("--%s=%s", config.ConfigFileKey, writeConfig(fmt.Sprintf("%s: %s", pluginDirKey, outsideDir)))},
+		{fmt.Sprintf("--%s=%s", config.ConfigContentKey, base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf(`{"Build-Dir":%q}`, outsideDir))))},
+	} {
+		err = start(args...)
+		assert.Error(err, args)
+		assert.Contains(status.Convert(err).Message(), ErrDirNotAllowed.Error(), args)
+	}
+
+	for _, args := range [][]string{
+		{fmt.Sprintf("--%s=%s", config.BuildDirKey, binaryDir)},
+		{"--" + pluginDirKey, pluginDir},
+		{fmt.Sprintf("--%s=%s", config.ConfigFileKey, writeConfig(fmt.Sprintf(`{"%s":%q}`, pluginDirKey, pluginDir)))},
+	} {
+		assert.NoError(start(args...), args)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package agent
+
+import (
+	"context"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/pkg/grpcauth"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+// agentNetwork is a local network whose nodes run on agents
+type agentNetwork struct {
+	network.Network
+	creator *NodeProcessCreator
+}
+
+// NewNetwork returns a new network from the given config, whose nodes run
+// on the agents at [endpoints], dialed with [dialTimeout] and authenticated
+// to as [auth] says. The runner keeps the files it writes for
+// the nodes under [rootDir] and its snapshots under [snapshotsDir], as
+// local.NewNetwork does, while each node keeps its database and logs on
+// the host of its agent. Nodes are reached at the host of their agent,
+// so [networkConfig] must not enable P2P proxies, which listen on this host.
+func NewNetwork(
+	log logging.Logger,
+	networkConfig network.Config,
+	endpoints []string,
+	dialTimeout time.Duration,
+	auth grpcauth.ClientConfig,
+	rootDir string,
+	snapshotsDir string,
+	events *network.EventBus,
+) (network.Network, error) {
+	if networkConfig.P2PProxy {
+		return nil, ErrP2PProxy
+	}
+	if networkConfig.DistinctNodeIPs {
+		return nil, ErrDistinctNodeIPs
+	}
+	creator, err := NewNodeProcessCreator(endpoints, dialTimeout, auth)
+	if err != nil {
+		return nil, err
+	}
+	nw, err := local.NewNetworkWithNodeProcessCreator(log, networkConfig, creator, rootDir, snapshotsDir, events)
+	if err != nil {
+		_ = creator.Close()
+		return nil, err
+	}
+	return &agentNetwork{
+		Network: nw,
+		creator: creator,
+	}, nil
+}
+
+// Stops the nodes, then closes the connections to the agents.
+// See network.Network
+func (n *agentNetwork) Stop(ctx context.Context) error {
+	err := n.Network.Stop(ctx)
+	if closeErr := n.creator.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
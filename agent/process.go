@@ -0,0 +1,324 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/pkg/grpcauth"
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanchego/config"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// Time to wait for an agent to answer a request,
+// other than a wait on a process
+const requestTimeout = 30 * time.Second
+
+// interface compliance
+var (
+	_ local.NodeProcessCreator = (*NodeProcessCreator)(nil)
+	_ local.RemoteNodeProcess  = (*remoteProcess)(nil)
+)
+
+var (
+	ErrNoAgents        = errors.New("no agent endpoints given")
+	ErrP2PProxy        = errors.New("nodes on agents can't be reached through P2P proxies")
+	ErrDistinctNodeIPs = errors.New("nodes on agents already have the IPs of their hosts")
+)
+
+// Flags pointing a node to files the runner wrote on its host,
+// which are sent to the agent
+var fileFlags = map[string]struct{}{
+	config.StakingKeyPathKey:    {},
+	config.StakingCertPathKey:   {},
+	config.GenesisConfigFileKey: {},
+	config.ConfigFileKey:        {},
+}
+
+// Directory the chain configs the runner wrote on its host
+// are sent to, in the directory of the node on the agent host
+const chainConfigDirName = "chain-configs"
+
+// Flags pointing a node to directories it writes to, which are
+// moved to these directories of the node on the agent host
+var dirFlags = map[string]string{
+	config.DBPathKey:  "db",
+	config.LogsDirKey: "logs",
+}
+
+// NodeProcessCreator runs the nodes of a network on a set of agents.
+// Nodes are placed on the agents in turn, and a node is restarted
+// on the agent it was placed on.
+type NodeProcessCreator struct {
+	endpoints []string
+	conns     []*grpc.ClientConn
+	clients   []rpcpb.AgentServiceClient
+
+	lock sync.Mutex
+	// Node name --> index of the agent it's placed on
+	placements map[string]int
+	// Index of the agent the next node is placed on
+	next int
+	// Node name --> its last process
+	processes map[string]*remoteProcess
+}
+
+// NewNodeProcessCreator connects to the agents at [endpoints],
+// waiting up to [dialTimeout] for each, authenticating as [auth] says.
+func NewNodeProcessCreator(endpoints []string, dialTimeout time.Duration, auth grpcauth.ClientConfig) (*NodeProcessCreator, error) {
+	if len(endpoints) == 0 {
+		return nil, ErrNoAgents
+	}
+	dialOpts, err := auth.DialOptions()
+	if err != nil {
+		return nil, err
+	}
+	dialOpts = append(dialOpts, grpc.WithBlock())
+	c := &NodeProcessCreator{
+		endpoints:  endpoints,
+		placements: map[string]int{},
+		processes:  map[string]*remoteProcess{},
+	}
+	for _, endpoint := range endpoints {
+		zap.L().Info("dialing agent", zap.String("endpoint", endpoint))
+		ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+		conn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+		cancel()
+		if err != nil {
+			_ = c.Close()
+			return nil, fmt.Errorf("couldn't dial agent %q: %w", endpoint, err)
+		}
+		c.conns = append(c.conns, conn)
+		c.clients = append(c.clients, rpcpb.NewAgentServiceClient(conn))
+	}
+	return c, nil
+}
+
+// NewNodeProcess returns a process running the node on its agent.
+// The files the node is pointed to by [args] are sent to the agent, and
+// the node keeps its database and logs in its directory on the agent host.
+// Other paths in [args] must be valid on the agent host.
+// See local.NodeProcessCreator
+func (c *NodeProcessCreator) NewNodeProcess(nodeConfig node.Config, args ...string) (local.NodeProcess, error) {
+	files := map[string][]byte{}
+	remoteArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		flagName, flagValue, ok := parseFlag(arg)
+		if !ok {
+			remoteArgs = append(remoteArgs, arg)
+			continue
+		}
+		if _, ok := fileFlags[flagName]; ok {
+			contents, err := os.ReadFile(flagValue)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't read file of flag %s: %w", flagName, err)
+			}
+			remotePath := filepath.Base(flagValue)
+			files[remotePath] = contents
+			remoteArgs = append(remoteArgs, fmt.Sprintf("--%s=%s", flagName, remotePath))
+			continue
+		}
+		if flagName == config.ChainConfigDirKey {
+			if err := readDir(flagValue, chainConfigDirName, files); err != nil {
+				return nil, fmt.Errorf("couldn't read dir of flag %s: %w", flagName, err)
+			}
+			remoteArgs = append(remoteArgs, fmt.Sprintf("--%s=%s", flagName, chainConfigDirName))
+			continue
+		}
+		if remoteDir, ok := dirFlags[flagName]; ok {
+			remoteArgs = append(remoteArgs, fmt.Sprintf("--%s=%s", flagName, remoteDir))
+			continue
+		}
+		remoteArgs = append(remoteArgs, arg)
+	}
+
+	process := &remoteProcess{
+		req: &rpcpb.StartProcessRequest{
+			Name:       nodeConfig.Name,
+			BinaryPath: nodeConfig.BinaryPath,
+			Args:       remoteArgs,
+			Files:      files,
+		},
+	}
+	c.place(nodeConfig.Name, process)
+	return process, nil
+}
+
+// TailLogs returns the last [lines] lines of the
+// output of the last process of node [nodeName]
+func (c *NodeProcessCreator) TailLogs(ctx context.Context, nodeName string, lines uint32) ([]string, error) {
+	c.lock.Lock()
+	process, ok := c.processes[nodeName]
+	c.lock.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("node %q not found", nodeName)
+	}
+	return process.TailLogs(ctx, lines)
+}
+
+// GetAgent returns the endpoint of the agent node [nodeName] is placed on
+func (c *NodeProcessCreator) GetAgent(nodeName string) (string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	i, ok := c.placements[nodeName]
+	if !ok {
+		return "", false
+	}
+	return c.endpoints[i], true
+}
+
+// Close closes the connections to the agents
+func (c *NodeProcessCreator) Close() error {
+	var errs []string
+	for _, conn := range c.conns {
+		if err := conn.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// place makes [process] the last process of node [nodeName], run by the
+// agent the node is placed on, placing it on the next agent if it isn't yet
+func (c *NodeProcessCreator) place(nodeName string, process *remoteProcess) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	i, ok := c.placements[nodeName]
+	if !ok {
+		i = c.next
+		c.placements[nodeName] = i
+		c.next = (c.next + 1) % len(c.clients)
+	}
+	process.client = c.clients[i]
+	c.processes[nodeName] = process
+}
+
+// remoteProcess is a node process run by an agent
+type remoteProcess struct {
+	client rpcpb.AgentServiceClient
+	req    *rpcpb.StartProcessRequest
+	// Set by Start
+	processID string
+	host      string
+}
+
+func (p *remoteProcess) Start() error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := p.client.StartProcess(ctx, p.req)
+	cancel()
+	if err != nil {
+		return err
+	}
+	p.processID = resp.ProcessId
+	p.host = resp.Host
+	return nil
+}
+
+func (p *remoteProcess) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	_, err := p.client.StopProcess(ctx, &rpcpb.StopProcessRequest{ProcessId: p.processID})
+	return err
+}
+
+func (p *remoteProcess) Kill() error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	_, err := p.client.KillProcess(ctx, &rpcpb.KillProcessRequest{ProcessId: p.processID})
+	return err
+}
+
+func (p *remoteProcess) Pause() error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	_, err := p.client.PauseProcess(ctx, &rpcpb.PauseProcessRequest{ProcessId: p.processID})
+	return err
+}
+
+func (p *remoteProcess) Resume() error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	_, err := p.client.ResumeProcess(ctx, &rpcpb.ResumeProcessRequest{ProcessId: p.processID})
+	return err
+}
+
+func (p *remoteProcess) Wait() error {
+	resp, err := p.client.WaitProcess(context.Background(), &rpcpb.WaitProcessRequest{ProcessId: p.processID})
+	if err != nil {
+		return fmt.Errorf("couldn't wait for process %q: %w", p.processID, err)
+	}
+	if resp.Error == "" {
+		return nil
+	}
+	return &local.ExitError{
+		ExitCode:   int(resp.ExitCode),
+		ExitSignal: resp.ExitSignal,
+	}
+}
+
+// See local.RemoteNodeProcess
+func (p *remoteProcess) GetHost() string {
+	return p.host
+}
+
+// TailLogs returns the last [lines] lines of the output of the process
+func (p *remoteProcess) TailLogs(ctx context.Context, lines uint32) ([]string, error) {
+	resp, err := p.client.TailLogs(ctx, &rpcpb.TailLogsRequest{ProcessId: p.processID, Lines: lines})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Lines, nil
+}
+
+// parseFlag returns the name and value of [arg] if it's a "--name=value" flag
+func parseFlag(arg string) (string, string, bool) {
+	if !strings.HasPrefix(arg, "--") {
+		return "", "", false
+	}
+	i := strings.Index(arg, "=")
+	if i < 0 {
+		return "", "", false
+	}
+	return arg[2:i], arg[i+1:], true
+}
+
+// readDir adds to [files] the files under directory [dir],
+// by their path relative to it under [remoteDir]
+func readDir(dir string, remoteDir string, files map[string][]byte) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[filepath.Join(remoteDir, relPath)] = contents
+		return nil
+	})
+}
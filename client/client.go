@@ -14,12 +14,12 @@ import (
 
 	"github.com/ava-labs/avalanche-network-runner/local"
 	"github.com/ava-labs/avalanche-network-runner/pkg/color"
+	"github.com/ava-labs/avalanche-network-runner/pkg/grpcauth"
 	"github.com/ava-labs/avalanche-network-runner/pkg/logutil"
 	"github.com/ava-labs/avalanche-network-runner/rpcpb"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 )
 
@@ -27,21 +27,55 @@ type Config struct {
 	LogLevel    string
 	Endpoint    string
 	DialTimeout time.Duration
+	// If true, the server is dialed over TLS. Implied by the TLS files.
+	TLS bool
+	// PEM file of the CAs the cert of the server is verified with.
+	// The CAs of the system are used if empty.
+	TLSCAFile string
+	// Cert presented to servers that require one (mTLS)
+	TLSCertFile string
+	TLSKeyFile  string
+	// If set, the name the cert of the server is verified for,
+	// instead of the host of [Endpoint]
+	TLSServerName string
+	// If set, sent as a bearer token with every request
+	AuthToken string
+	// Network of the server the requests are for.
+	// The default network of the server if empty.
+	NetworkName string
 }
 
 type Client interface {
 	Ping(ctx context.Context) (*rpcpb.PingResponse, error)
 	Start(ctx context.Context, execPath string, opts ...OpOption) (*rpcpb.StartResponse, error)
-	Health(ctx context.Context) (*rpcpb.HealthResponse, error)
+	Health(ctx context.Context, nodeNames ...string) (*rpcpb.HealthResponse, error)
 	URIs(ctx context.Context) ([]string, error)
 	Status(ctx context.Context) (*rpcpb.StatusResponse, error)
 	StreamStatus(ctx context.Context, pushInterval time.Duration) (<-chan *rpcpb.ClusterInfo, error)
+	WatchEvents(ctx context.Context, eventTypes []string, nodeNames []string) (<-chan *rpcpb.Event, error)
+	StreamLogs(ctx context.Context, opts ...OpOption) (<-chan *rpcpb.LogLine, error)
+	GetResourceUsage(ctx context.Context, nodeNames ...string) (*rpcpb.GetResourceUsageResponse, error)
 	RemoveNode(ctx context.Context, name string) (*rpcpb.RemoveNodeResponse, error)
 	RestartNode(ctx context.Context, name string, opts ...OpOption) (*rpcpb.RestartNodeResponse, error)
+	RollingUpgrade(ctx context.Context, execPath string, opts ...OpOption) (*rpcpb.RollingUpgradeResponse, error)
 	AddNode(ctx context.Context, name string, execPath string, opts ...OpOption) (*rpcpb.AddNodeResponse, error)
+	Apply(ctx context.Context, spec string, opts ...OpOption) (*rpcpb.ApplyResponse, error)
+	PauseNode(ctx context.Context, name string) (*rpcpb.PauseNodeResponse, error)
+	ResumeNode(ctx context.Context, name string) (*rpcpb.ResumeNodeResponse, error)
+	SetBeacon(ctx context.Context, name string, isBeacon bool) (*rpcpb.SetBeaconResponse, error)
+	CreatePartition(ctx context.Context, groups [][]string) (*rpcpb.CreatePartitionResponse, error)
+	HealPartition(ctx context.Context) (*rpcpb.HealPartitionResponse, error)
+	SetFaults(ctx context.Context, nodeName string, peerName string, faults *rpcpb.LinkFaults) (*rpcpb.SetFaultsResponse, error)
 	Stop(ctx context.Context) (*rpcpb.StopResponse, error)
 	AttachPeer(ctx context.Context, nodeName string) (*rpcpb.AttachPeerResponse, error)
 	SendOutboundMessage(ctx context.Context, nodeName string, peerID string, op uint32, msgBody []byte) (*rpcpb.SendOutboundMessageResponse, error)
+	SaveSnapshot(ctx context.Context, snapshotName string) (string, error)
+	LoadSnapshot(ctx context.Context, snapshotName string, opts ...OpOption) (*rpcpb.LoadSnapshotResponse, error)
+	RemoveSnapshot(ctx context.Context, snapshotName string) error
+	ListSnapshots(ctx context.Context) ([]string, error)
+	ListNetworks(ctx context.Context) (*rpcpb.ListNetworksResponse, error)
+	StopAll(ctx context.Context) ([]string, error)
+	Resume(ctx context.Context, rootDataDir string) (*rpcpb.ResumeResponse, error)
 	Close() error
 }
 
@@ -66,14 +100,15 @@ func New(cfg Config) (Client, error) {
 	}
 	_ = zap.ReplaceGlobals(logger)
 
+	dialOpts, err := cfg.dialOptions()
+	if err != nil {
+		return nil, err
+	}
+	dialOpts = append(dialOpts, grpc.WithBlock())
+
 	color.Outf("{{blue}}dialing endpoint %q{{/}}\n", cfg.Endpoint)
 	ctx, cancel := context.WithTimeout(context.Background(), cfg.DialTimeout)
-	conn, err := grpc.DialContext(
-		ctx,
-		cfg.Endpoint,
-		grpc.WithBlock(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	conn, err := grpc.DialContext(ctx, cfg.Endpoint, dialOpts...)
 	cancel()
 	if err != nil {
 		return nil, err
@@ -88,6 +123,18 @@ func New(cfg Config) (Client, error) {
 	}, nil
 }
 
+// dialOptions returns the options to dial the server with
+func (cfg Config) dialOptions() ([]grpc.DialOption, error) {
+	return grpcauth.ClientConfig{
+		TLS:           cfg.TLS,
+		TLSCAFile:     cfg.TLSCAFile,
+		TLSCertFile:   cfg.TLSCertFile,
+		TLSKeyFile:    cfg.TLSKeyFile,
+		TLSServerName: cfg.TLSServerName,
+		AuthToken:     cfg.AuthToken,
+	}.DialOptions()
+}
+
 func (c *client) Ping(ctx context.Context) (*rpcpb.PingResponse, error) {
 	zap.L().Info("ping")
 
@@ -101,8 +148,9 @@ func (c *client) Start(ctx context.Context, execPath string, opts ...OpOption) (
 	ret.applyOpts(opts)
 
 	req := &rpcpb.StartRequest{
-		ExecPath: execPath,
-		NumNodes: &ret.numNodes,
+		ExecPath:    execPath,
+		NumNodes:    &ret.numNodes,
+		NetworkName: c.cfg.NetworkName,
 	}
 	if ret.whitelistedSubnets != "" {
 		req.WhitelistedSubnets = &ret.whitelistedSubnets
@@ -125,19 +173,40 @@ func (c *client) Start(ctx context.Context, execPath string, opts ...OpOption) (
 	if ret.customNodeConfigs != nil {
 		req.CustomNodeConfigs = ret.customNodeConfigs
 	}
+	if ret.p2pProxy {
+		req.P2PProxy = &ret.p2pProxy
+	}
+	if ret.distinctNodeIPs {
+		req.DistinctNodeIps = &ret.distinctNodeIPs
+	}
+	if ret.identitySeed != "" {
+		req.IdentitySeed = &ret.identitySeed
+	}
+	if ret.genesisSpec != "" {
+		req.GenesisSpec = &ret.genesisSpec
+	}
+	if ret.cChainAllocations != "" {
+		req.CChainAllocations = &ret.cChainAllocations
+	}
+	if ret.spec != "" {
+		req.Spec = &ret.spec
+	}
+	if ret.numBeacons != 0 {
+		req.NumBeacons = &ret.numBeacons
+	}
 
 	zap.L().Info("start")
 	return c.controlc.Start(ctx, req)
 }
 
-func (c *client) Health(ctx context.Context) (*rpcpb.HealthResponse, error) {
-	zap.L().Info("health")
-	return c.controlc.Health(ctx, &rpcpb.HealthRequest{})
+func (c *client) Health(ctx context.Context, nodeNames ...string) (*rpcpb.HealthResponse, error) {
+	zap.L().Info("health", zap.Strings("node-names", nodeNames))
+	return c.controlc.Health(ctx, &rpcpb.HealthRequest{NodeNames: nodeNames, NetworkName: c.cfg.NetworkName})
 }
 
 func (c *client) URIs(ctx context.Context) ([]string, error) {
 	zap.L().Info("uris")
-	resp, err := c.controlc.URIs(ctx, &rpcpb.URIsRequest{})
+	resp, err := c.controlc.URIs(ctx, &rpcpb.URIsRequest{NetworkName: c.cfg.NetworkName})
 	if err != nil {
 		return nil, err
 	}
@@ -146,12 +215,13 @@ func (c *client) URIs(ctx context.Context) ([]string, error) {
 
 func (c *client) Status(ctx context.Context) (*rpcpb.StatusResponse, error) {
 	zap.L().Info("status")
-	return c.controlc.Status(ctx, &rpcpb.StatusRequest{})
+	return c.controlc.Status(ctx, &rpcpb.StatusRequest{NetworkName: c.cfg.NetworkName})
 }
 
 func (c *client) StreamStatus(ctx context.Context, pushInterval time.Duration) (<-chan *rpcpb.ClusterInfo, error) {
 	stream, err := c.controlc.StreamStatus(ctx, &rpcpb.StreamStatusRequest{
 		PushInterval: int64(pushInterval),
+		NetworkName:  c.cfg.NetworkName,
 	})
 	if err != nil {
 		return nil, err
@@ -196,9 +266,111 @@ func (c *client) StreamStatus(ctx context.Context, pushInterval time.Duration) (
 	return ch, nil
 }
 
+func (c *client) WatchEvents(ctx context.Context, eventTypes []string, nodeNames []string) (<-chan *rpcpb.Event, error) {
+	stream, err := c.controlc.WatchEvents(ctx, &rpcpb.WatchEventsRequest{
+		EventTypes:  eventTypes,
+		NodeNames:   nodeNames,
+		NetworkName: c.cfg.NetworkName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *rpcpb.Event, 1)
+	go func() {
+		defer func() {
+			zap.L().Debug("closing stream send", zap.Error(stream.CloseSend()))
+			close(ch)
+		}()
+		zap.L().Info("start receive routine")
+		for {
+			resp, err := stream.Recv()
+			if err == nil {
+				select {
+				case ch <- resp.GetEvent():
+					continue
+				case <-ctx.Done():
+					return
+				case <-c.closed:
+					return
+				}
+			}
+
+			if errors.Is(err, io.EOF) {
+				zap.L().Debug("received EOF from server; closing the events stream")
+				return
+			}
+			if isClientCanceled(stream.Context().Err(), err) {
+				zap.L().Warn("failed to receive events from gRPC stream due to client cancellation", zap.Error(err))
+			} else {
+				zap.L().Warn("failed to receive events from gRPC stream", zap.Error(err))
+			}
+			return
+		}
+	}()
+	return ch, nil
+}
+
+func (c *client) GetResourceUsage(ctx context.Context, nodeNames ...string) (*rpcpb.GetResourceUsageResponse, error) {
+	zap.L().Debug("get resource usage", zap.Strings("node-names", nodeNames))
+	return c.controlc.GetResourceUsage(ctx, &rpcpb.GetResourceUsageRequest{NodeNames: nodeNames, NetworkName: c.cfg.NetworkName})
+}
+
+func (c *client) StreamLogs(ctx context.Context, opts ...OpOption) (<-chan *rpcpb.LogLine, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	stream, err := c.controlc.StreamLogs(ctx, &rpcpb.StreamLogsRequest{
+		NodeNames:   ret.nodeNames,
+		Sources:     ret.logSources,
+		Follow:      ret.follow,
+		TailLines:   ret.tailLines,
+		Level:       ret.minLogLevel,
+		Regex:       ret.logRegex,
+		NetworkName: c.cfg.NetworkName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *rpcpb.LogLine, 1)
+	go func() {
+		defer func() {
+			zap.L().Debug("closing stream send", zap.Error(stream.CloseSend()))
+			close(ch)
+		}()
+		zap.L().Info("start receive routine")
+		for {
+			resp, err := stream.Recv()
+			if err == nil {
+				select {
+				case ch <- resp.GetLine():
+					continue
+				case <-ctx.Done():
+					return
+				case <-c.closed:
+					return
+				}
+			}
+
+			if errors.Is(err, io.EOF) {
+				zap.L().Debug("received EOF from server; closing the logs stream")
+				return
+			}
+			if isClientCanceled(stream.Context().Err(), err) {
+				zap.L().Warn("failed to receive logs from gRPC stream due to client cancellation", zap.Error(err))
+			} else {
+				zap.L().Warn("failed to receive logs from gRPC stream", zap.Error(err))
+			}
+			return
+		}
+	}()
+	return ch, nil
+}
+
 func (c *client) Stop(ctx context.Context) (*rpcpb.StopResponse, error) {
 	zap.L().Info("stop")
-	return c.controlc.Stop(ctx, &rpcpb.StopRequest{})
+	return c.controlc.Stop(ctx, &rpcpb.StopRequest{NetworkName: c.cfg.NetworkName})
 }
 
 func (c *client) AddNode(ctx context.Context, name string, execPath string, opts ...OpOption) (*rpcpb.AddNodeResponse, error) {
@@ -206,8 +378,10 @@ func (c *client) AddNode(ctx context.Context, name string, execPath string, opts
 	ret.applyOpts(opts)
 
 	req := &rpcpb.AddNodeRequest{
-		Name:         name,
-		StartRequest: &rpcpb.StartRequest{},
+		Name:           name,
+		StartRequest:   &rpcpb.StartRequest{},
+		BootstrapNodes: ret.bootstrapNodes,
+		NetworkName:    c.cfg.NetworkName,
 	}
 	if ret.whitelistedSubnets != "" {
 		req.StartRequest.WhitelistedSubnets = &ret.whitelistedSubnets
@@ -221,6 +395,9 @@ func (c *client) AddNode(ctx context.Context, name string, execPath string, opts
 	if ret.pluginDir != "" {
 		req.StartRequest.PluginDir = &ret.pluginDir
 	}
+	if ret.identitySeed != "" {
+		req.StartRequest.IdentitySeed = &ret.identitySeed
+	}
 
 	zap.L().Info("add node", zap.String("name", name))
 	return c.controlc.AddNode(ctx, req)
@@ -228,14 +405,14 @@ func (c *client) AddNode(ctx context.Context, name string, execPath string, opts
 
 func (c *client) RemoveNode(ctx context.Context, name string) (*rpcpb.RemoveNodeResponse, error) {
 	zap.L().Info("remove node", zap.String("name", name))
-	return c.controlc.RemoveNode(ctx, &rpcpb.RemoveNodeRequest{Name: name})
+	return c.controlc.RemoveNode(ctx, &rpcpb.RemoveNodeRequest{Name: name, NetworkName: c.cfg.NetworkName})
 }
 
 func (c *client) RestartNode(ctx context.Context, name string, opts ...OpOption) (*rpcpb.RestartNodeResponse, error) {
 	ret := &Op{}
 	ret.applyOpts(opts)
 
-	req := &rpcpb.RestartNodeRequest{Name: name}
+	req := &rpcpb.RestartNodeRequest{Name: name, NetworkName: c.cfg.NetworkName}
 	if ret.execPath != "" {
 		req.ExecPath = &ret.execPath
 	}
@@ -253,18 +430,149 @@ func (c *client) RestartNode(ctx context.Context, name string, opts ...OpOption)
 	return c.controlc.RestartNode(ctx, req)
 }
 
+func (c *client) RollingUpgrade(ctx context.Context, execPath string, opts ...OpOption) (*rpcpb.RollingUpgradeResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	req := &rpcpb.RollingUpgradeRequest{
+		ExecPath:        execPath,
+		NodeNames:       ret.nodeNames,
+		BatchSize:       ret.batchSize,
+		HealthTimeoutMs: uint64(ret.healthTimeout / time.Millisecond),
+		Rollback:        ret.rollback,
+		NetworkName:     c.cfg.NetworkName,
+	}
+
+	zap.L().Info("rolling upgrade", zap.String("exec-path", execPath), zap.Strings("node-names", ret.nodeNames))
+	return c.controlc.RollingUpgrade(ctx, req)
+}
+
+func (c *client) Apply(ctx context.Context, spec string, opts ...OpOption) (*rpcpb.ApplyResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("apply", zap.Bool("dry-run", ret.dryRun))
+	return c.controlc.Apply(ctx, &rpcpb.ApplyRequest{Spec: spec, DryRun: ret.dryRun, NetworkName: c.cfg.NetworkName})
+}
+
+func (c *client) PauseNode(ctx context.Context, name string) (*rpcpb.PauseNodeResponse, error) {
+	zap.L().Info("pause node", zap.String("name", name))
+	return c.controlc.PauseNode(ctx, &rpcpb.PauseNodeRequest{Name: name, NetworkName: c.cfg.NetworkName})
+}
+
+func (c *client) ResumeNode(ctx context.Context, name string) (*rpcpb.ResumeNodeResponse, error) {
+	zap.L().Info("resume node", zap.String("name", name))
+	return c.controlc.ResumeNode(ctx, &rpcpb.ResumeNodeRequest{Name: name, NetworkName: c.cfg.NetworkName})
+}
+
+func (c *client) SetBeacon(ctx context.Context, name string, isBeacon bool) (*rpcpb.SetBeaconResponse, error) {
+	zap.L().Info("set beacon", zap.String("name", name), zap.Bool("is-beacon", isBeacon))
+	return c.controlc.SetBeacon(ctx, &rpcpb.SetBeaconRequest{Name: name, IsBeacon: isBeacon, NetworkName: c.cfg.NetworkName})
+}
+
+func (c *client) CreatePartition(ctx context.Context, groups [][]string) (*rpcpb.CreatePartitionResponse, error) {
+	zap.L().Info("create partition", zap.Any("groups", groups))
+	req := &rpcpb.CreatePartitionRequest{NetworkName: c.cfg.NetworkName}
+	for _, group := range groups {
+		req.Groups = append(req.Groups, &rpcpb.PartitionGroup{NodeNames: group})
+	}
+	return c.controlc.CreatePartition(ctx, req)
+}
+
+func (c *client) HealPartition(ctx context.Context) (*rpcpb.HealPartitionResponse, error) {
+	zap.L().Info("heal partition")
+	return c.controlc.HealPartition(ctx, &rpcpb.HealPartitionRequest{NetworkName: c.cfg.NetworkName})
+}
+
+func (c *client) SetFaults(ctx context.Context, nodeName string, peerName string, faults *rpcpb.LinkFaults) (*rpcpb.SetFaultsResponse, error) {
+	zap.L().Info("set faults",
+		zap.String("node-name", nodeName),
+		zap.String("peer-name", peerName),
+		zap.Any("faults", faults),
+	)
+	return c.controlc.SetFaults(ctx, &rpcpb.SetFaultsRequest{
+		NodeName:    nodeName,
+		PeerName:    peerName,
+		Faults:      faults,
+		NetworkName: c.cfg.NetworkName,
+	})
+}
+
 func (c *client) AttachPeer(ctx context.Context, nodeName string) (*rpcpb.AttachPeerResponse, error) {
 	zap.L().Info("attaching peer", zap.String("node-name", nodeName))
-	return c.controlc.AttachPeer(ctx, &rpcpb.AttachPeerRequest{NodeName: nodeName})
+	return c.controlc.AttachPeer(ctx, &rpcpb.AttachPeerRequest{NodeName: nodeName, NetworkName: c.cfg.NetworkName})
 }
 
 func (c *client) SendOutboundMessage(ctx context.Context, nodeName string, peerID string, op uint32, msgBody []byte) (*rpcpb.SendOutboundMessageResponse, error) {
 	zap.L().Info("sending outbound message", zap.String("node-name", nodeName), zap.String("peer-id", peerID))
 	return c.controlc.SendOutboundMessage(ctx, &rpcpb.SendOutboundMessageRequest{
-		NodeName: nodeName,
-		PeerId:   peerID,
-		Op:       op,
-		Bytes:    msgBody,
+		NodeName:    nodeName,
+		PeerId:      peerID,
+		Op:          op,
+		Bytes:       msgBody,
+		NetworkName: c.cfg.NetworkName,
+	})
+}
+
+func (c *client) SaveSnapshot(ctx context.Context, snapshotName string) (string, error) {
+	zap.L().Info("save snapshot", zap.String("snapshot-name", snapshotName))
+	resp, err := c.controlc.SaveSnapshot(ctx, &rpcpb.SaveSnapshotRequest{SnapshotName: snapshotName, NetworkName: c.cfg.NetworkName})
+	if err != nil {
+		return "", err
+	}
+	return resp.SnapshotPath, nil
+}
+
+func (c *client) LoadSnapshot(ctx context.Context, snapshotName string, opts ...OpOption) (*rpcpb.LoadSnapshotResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	req := &rpcpb.LoadSnapshotRequest{SnapshotName: snapshotName, NetworkName: c.cfg.NetworkName}
+	if ret.execPath != "" {
+		req.ExecPath = &ret.execPath
+	}
+	if ret.rootDataDir != "" {
+		req.RootDataDir = &ret.rootDataDir
+	}
+
+	zap.L().Info("load snapshot", zap.String("snapshot-name", snapshotName))
+	return c.controlc.LoadSnapshot(ctx, req)
+}
+
+func (c *client) RemoveSnapshot(ctx context.Context, snapshotName string) error {
+	zap.L().Info("remove snapshot", zap.String("snapshot-name", snapshotName))
+	_, err := c.controlc.RemoveSnapshot(ctx, &rpcpb.RemoveSnapshotRequest{SnapshotName: snapshotName})
+	return err
+}
+
+func (c *client) ListSnapshots(ctx context.Context) ([]string, error) {
+	zap.L().Info("list snapshots")
+	resp, err := c.controlc.ListSnapshots(ctx, &rpcpb.ListSnapshotsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.SnapshotNames, nil
+}
+
+func (c *client) ListNetworks(ctx context.Context) (*rpcpb.ListNetworksResponse, error) {
+	zap.L().Info("list networks")
+	return c.controlc.ListNetworks(ctx, &rpcpb.ListNetworksRequest{})
+}
+
+func (c *client) StopAll(ctx context.Context) ([]string, error) {
+	zap.L().Info("stop all")
+	resp, err := c.controlc.StopAll(ctx, &rpcpb.StopAllRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.NetworkNames, nil
+}
+
+func (c *client) Resume(ctx context.Context, rootDataDir string) (*rpcpb.ResumeResponse, error) {
+	zap.L().Info("resume", zap.String("root-data-dir", rootDataDir))
+	return c.controlc.Resume(ctx, &rpcpb.ResumeRequest{
+		RootDataDir: rootDataDir,
+		NetworkName: c.cfg.NetworkName,
 	})
 }
 
@@ -277,6 +585,8 @@ func (c *client) Close() error {
 
 type Op struct {
 	numNodes           uint32
+	numBeacons         uint32
+	bootstrapNodes     []string
 	execPath           string
 	whitelistedSubnets string
 	logLevel           string
@@ -285,6 +595,22 @@ type Op struct {
 	pluginDir          string
 	customVMs          map[string]string
 	customNodeConfigs  map[string]string
+	p2pProxy           bool
+	distinctNodeIPs    bool
+	identitySeed       string
+	genesisSpec        string
+	cChainAllocations  string
+	spec               string
+	nodeNames          []string
+	batchSize          uint32
+	healthTimeout      time.Duration
+	rollback           bool
+	dryRun             bool
+	logSources         []string
+	follow             bool
+	tailLines          uint32
+	minLogLevel        string
+	logRegex           string
 }
 
 type OpOption func(*Op)
@@ -307,6 +633,21 @@ func WithNumNodes(numNodes uint32) OpOption {
 	}
 }
 
+// Number of the nodes that are beacons, the first ones.
+// All the nodes are if not given.
+func WithNumBeacons(numBeacons uint32) OpOption {
+	return func(op *Op) {
+		op.numBeacons = numBeacons
+	}
+}
+
+// Nodes an added node bootstraps from, instead of the beacons
+func WithBootstrapNodes(bootstrapNodes []string) OpOption {
+	return func(op *Op) {
+		op.bootstrapNodes = bootstrapNodes
+	}
+}
+
 func WithExecPath(execPath string) OpOption {
 	return func(op *Op) {
 		op.execPath = execPath
@@ -351,6 +692,129 @@ func WithCustomNodeConfigs(customNodeConfigs map[string]string) OpOption {
 	}
 }
 
+// If true, nodes reach each other through proxies,
+// so the network can be partitioned
+func WithP2PProxy(p2pProxy bool) OpOption {
+	return func(op *Op) {
+		op.p2pProxy = p2pProxy
+	}
+}
+
+// If true, each node gets its own loopback IP, 127.0.1.N,
+// to simulate a network of distinct hosts
+func WithDistinctNodeIPs(distinctNodeIPs bool) OpOption {
+	return func(op *Op) {
+		op.distinctNodeIPs = distinctNodeIPs
+	}
+}
+
+// Seed of the staking identities of the nodes, so they get the same node IDs
+// on every run. See local.NewDefaultConfigNNodesFromSeed.
+func WithIdentitySeed(identitySeed string) OpOption {
+	return func(op *Op) {
+		op.identitySeed = identitySeed
+	}
+}
+
+// JSON spec of a network.GenesisBuilder to build the genesis from
+func WithGenesisSpec(genesisSpec string) OpOption {
+	return func(op *Op) {
+		op.genesisSpec = genesisSpec
+	}
+}
+
+// Accounts added to the C-Chain genesis, as a JSON in the format
+// of the alloc of a geth genesis. See network.MarshalCChainAllocations.
+func WithCChainAllocations(cChainAllocations string) OpOption {
+	return func(op *Op) {
+		op.cChainAllocations = cChainAllocations
+	}
+}
+
+// JSON of the network spec the network is created from, replacing
+// the other options of the network. See spec.Load.
+func WithSpec(spec string) OpOption {
+	return func(op *Op) {
+		op.spec = spec
+	}
+}
+
+// Nodes to upgrade, in order, or to stream the logs of.
+// If empty, all nodes are used.
+func WithNodeNames(nodeNames []string) OpOption {
+	return func(op *Op) {
+		op.nodeNames = nodeNames
+	}
+}
+
+// Number of nodes upgraded at once
+func WithBatchSize(batchSize uint32) OpOption {
+	return func(op *Op) {
+		op.batchSize = batchSize
+	}
+}
+
+// Time to wait for each batch of upgraded nodes to be healthy
+func WithHealthTimeout(healthTimeout time.Duration) OpOption {
+	return func(op *Op) {
+		op.healthTimeout = healthTimeout
+	}
+}
+
+// If true, the upgraded nodes are restarted on
+// their previous binary if the upgrade fails
+func WithRollback(rollback bool) OpOption {
+	return func(op *Op) {
+		op.rollback = rollback
+	}
+}
+
+// If true, the steps of an apply are returned but not executed
+func WithDryRun(dryRun bool) OpOption {
+	return func(op *Op) {
+		op.dryRun = dryRun
+	}
+}
+
+// Logs to stream: "stdout", "stderr", or the name of an
+// avalanchego log file without its extension (e.g. "main", "C").
+// If empty, the output of the node processes is streamed.
+func WithLogSources(logSources []string) OpOption {
+	return func(op *Op) {
+		op.logSources = logSources
+	}
+}
+
+// If true, the lines appended to the logs are streamed
+// until the context is canceled
+func WithFollow(follow bool) OpOption {
+	return func(op *Op) {
+		op.follow = follow
+	}
+}
+
+// Number of last lines of each log streamed before following.
+// If 0, all the lines are streamed.
+func WithTailLines(tailLines uint32) OpOption {
+	return func(op *Op) {
+		op.tailLines = tailLines
+	}
+}
+
+// Only stream log lines of this level or a more severe one
+func WithMinLogLevel(minLogLevel string) OpOption {
+	return func(op *Op) {
+		op.minLogLevel = minLogLevel
+	}
+}
+
+// Only stream log lines matching this regular expression
+func WithLogRegex(logRegex string) OpOption {
+	return func(op *Op) {
+		op.logRegex = logRegex
+	}
+}
+
 func isClientCanceled(ctxErr error, err error) bool {
 	if ctxErr != nil {
 		return true
@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package agent
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ava-labs/avalanche-network-runner/agent"
+	"github.com/ava-labs/avalanche-network-runner/pkg/logutil"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func init() {
+	cobra.EnablePrefixMatching = true
+}
+
+var (
+	logLevel        string
+	port            string
+	host            string
+	rootDir         string
+	binaryDirs      []string
+	tlsCertFile     string
+	tlsKeyFile      string
+	tlsClientCAFile string
+	authTokens      []string
+)
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "agent [options]",
+		Short: "Start an agent running avalanchego nodes on this host for a network runner.",
+		RunE:  agentFunc,
+	}
+
+	cmd.PersistentFlags().StringVar(&logLevel, "log-level", logutil.DefaultLogLevel, "log level")
+	cmd.PersistentFlags().StringVar(&port, "port", agent.DefaultPort, "agent port (e.g. :9090 to be reachable from other hosts)")
+	cmd.PersistentFlags().StringVar(&host, "host", "127.0.0.1", "IP the nodes on this host are reachable at")
+	cmd.PersistentFlags().StringVar(&rootDir, "root-dir", "", "directory the nodes are run in (default: a new temporary directory)")
+	cmd.PersistentFlags().StringSliceVar(&binaryDirs, "binary-dirs", nil, "comma separated directories the binaries the agent runs must be in")
+	cmd.PersistentFlags().StringVar(&tlsCertFile, "tls-cert-file", "", "[optional] cert file to serve gRPC over TLS with")
+	cmd.PersistentFlags().StringVar(&tlsKeyFile, "tls-key-file", "", "[optional] key file of the TLS cert")
+	cmd.PersistentFlags().StringVar(&tlsClientCAFile, "tls-client-ca-file", "", "[optional] PEM file of the CAs that must sign the client certs (mTLS)")
+	cmd.PersistentFlags().StringSliceVar(&authTokens, "auth-tokens", nil, "[optional] bearer tokens, one of which the requests must carry")
+
+	return cmd
+}
+
+func agentFunc(cmd *cobra.Command, args []string) (err error) {
+	lcfg := logutil.GetDefaultZapLoggerConfig()
+	lcfg.Level = zap.NewAtomicLevelAt(logutil.ConvertToZapLevel(logLevel))
+	logger, err := lcfg.Build()
+	if err != nil {
+		log.Fatalf("failed to build global logger, %v", err)
+	}
+	_ = zap.ReplaceGlobals(logger)
+
+	a, err := agent.New(agent.Config{
+		Port:            port,
+		Host:            host,
+		RootDir:         rootDir,
+		BinaryDirs:      binaryDirs,
+		TLSCertFile:     tlsCertFile,
+		TLSKeyFile:      tlsKeyFile,
+		TLSClientCAFile: tlsClientCAFile,
+		AuthTokens:      authTokens,
+	})
+	if err != nil {
+		return err
+	}
+
+	rootCtx, rootCancel := context.WithCancel(context.Background())
+	errc := make(chan error)
+	go func() {
+		errc <- a.Run(rootCtx)
+	}()
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case sig := <-sigc:
+		zap.L().Warn("signal received; closing agent", zap.String("signal", sig.String()))
+		rootCancel()
+		zap.L().Warn("closed agent", zap.Error(<-errc))
+	case err = <-errc:
+		zap.L().Warn("agent closed", zap.Error(err))
+		rootCancel()
+	}
+	return err
+}
@@ -10,13 +10,20 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"sort"
+	"strings"
 	"syscall"
+	"text/tabwriter"
 	"time"
 
 	"github.com/ava-labs/avalanche-network-runner/client"
 	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/network"
 	"github.com/ava-labs/avalanche-network-runner/pkg/color"
 	"github.com/ava-labs/avalanche-network-runner/pkg/logutil"
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanche-network-runner/server"
+	"github.com/ava-labs/avalanche-network-runner/spec"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 )
@@ -31,6 +38,15 @@ var (
 	endpoint           string
 	dialTimeout        time.Duration
 	requestTimeout     time.Duration
+
+	tlsEnabled    bool
+	tlsCAFile     string
+	tlsCertFile   string
+	tlsKeyFile    string
+	tlsServerName string
+	authToken     string
+
+	networkName string
 )
 
 // NOTE: Naming convention for node names is currently `node` + number, i.e. `node1,node2,node3,...node101`
@@ -45,6 +61,13 @@ func NewCommand() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&endpoint, "endpoint", "0.0.0.0:8080", "server endpoint")
 	cmd.PersistentFlags().DurationVar(&dialTimeout, "dial-timeout", 10*time.Second, "server dial timeout")
 	cmd.PersistentFlags().DurationVar(&requestTimeout, "request-timeout", 3*time.Minute, "client request timeout")
+	cmd.PersistentFlags().BoolVar(&tlsEnabled, "tls", false, "[optional] true to dial the server over TLS (implied by the other TLS flags)")
+	cmd.PersistentFlags().StringVar(&tlsCAFile, "tls-ca-file", "", "[optional] PEM file of the CAs to verify the server cert with (system CAs if empty)")
+	cmd.PersistentFlags().StringVar(&tlsCertFile, "tls-cert-file", "", "[optional] client cert file, for servers that require one (mTLS)")
+	cmd.PersistentFlags().StringVar(&tlsKeyFile, "tls-key-file", "", "[optional] client key file, for servers that require a cert (mTLS)")
+	cmd.PersistentFlags().StringVar(&tlsServerName, "tls-server-name", "", "[optional] name to verify the server cert for, instead of the endpoint host")
+	cmd.PersistentFlags().StringVar(&authToken, "auth-token", os.Getenv("NETWORK_RUNNER_AUTH_TOKEN"), "[optional] bearer token sent with every request (defaults to $NETWORK_RUNNER_AUTH_TOKEN)")
+	cmd.PersistentFlags().StringVar(&networkName, "network-name", "", "[optional] network of the server to control (its default network if empty)")
 
 	cmd.AddCommand(
 		newStartCommand(),
@@ -52,25 +75,68 @@ func NewCommand() *cobra.Command {
 		newURIsCommand(),
 		newStatusCommand(),
 		newStreamStatusCommand(),
+		newEventsCommand(),
+		newLogsCommand(),
+		newTopCommand(),
 		newAddNodeCommand(),
 		newRemoveNodeCommand(),
 		newRestartNodeCommand(),
+		newRollingUpgradeCommand(),
+		newApplyCommand(),
+		newPauseNodeCommand(),
+		newResumeNodeCommand(),
+		newSetBeaconCommand(),
+		newCreatePartitionCommand(),
+		newHealPartitionCommand(),
+		newSetFaultsCommand(),
 		newAttachPeerCommand(),
 		newSendOutboundMessageCommand(),
 		newStopCommand(),
+		newSaveSnapshotCommand(),
+		newLoadSnapshotCommand(),
+		newRemoveSnapshotCommand(),
+		newListSnapshotsCommand(),
+		newListNetworksCommand(),
+		newStopAllCommand(),
+		newResumeCommand(),
+		newCleanupCommand(),
 	)
 
 	return cmd
 }
 
+func newClient() (client.Client, error) {
+	return client.New(client.Config{
+		LogLevel:      logLevel,
+		Endpoint:      endpoint,
+		DialTimeout:   dialTimeout,
+		TLS:           tlsEnabled,
+		TLSCAFile:     tlsCAFile,
+		TLSCertFile:   tlsCertFile,
+		TLSKeyFile:    tlsKeyFile,
+		TLSServerName: tlsServerName,
+		AuthToken:     authToken,
+		NetworkName:   networkName,
+	})
+}
+
 var (
 	avalancheGoBinPath        string
 	numNodes                  uint32
+	numBeacons                uint32
+	bootstrapNodes            []string
 	pluginDir                 string
 	globalNodeConfig          string
 	addNodeConfig             string
 	customVMNameToGenesisPath string
 	customNodeConfigs         string
+	p2pProxy                  bool
+	distinctNodeIPs           bool
+	identitySeed              string
+	genesisSpec               string
+	cChainAllocationsPath     string
+	cChainArtifacts           string
+	specPath                  string
 )
 
 func newStartCommand() *cobra.Command {
@@ -91,6 +157,12 @@ func newStartCommand() *cobra.Command {
 		local.DefaultNumNodes,
 		"number of nodes of the network",
 	)
+	cmd.PersistentFlags().Uint32Var(
+		&numBeacons,
+		"number-of-beacons",
+		0,
+		"[optional] number of the nodes that are beacons, the first ones (default all)",
+	)
 	cmd.PersistentFlags().StringVar(
 		&pluginDir,
 		"plugin-dir",
@@ -115,15 +187,53 @@ func newStartCommand() *cobra.Command {
 		"",
 		"[optional] custom node configs as JSON string of map, for each node individually. Common entries override `global-node-config`, but can be combined. Invalidates `number-of-nodes` (provide all node configs if used).",
 	)
+	cmd.PersistentFlags().BoolVar(
+		&p2pProxy,
+		"p2p-proxy",
+		false,
+		"[optional] true to make nodes reach each other through proxies, so the network can be partitioned",
+	)
+	cmd.PersistentFlags().BoolVar(
+		&distinctNodeIPs,
+		"distinct-node-ips",
+		false,
+		"[optional] true to give each node its own loopback IP (127.0.1.N), to simulate a network of distinct hosts",
+	)
+	cmd.PersistentFlags().StringVar(
+		&identitySeed,
+		"identity-seed",
+		"",
+		"[optional] seed of the staking identities of the nodes past the default ones, which get the same node IDs on every run",
+	)
+	cmd.PersistentFlags().StringVar(
+		&genesisSpec,
+		"genesis-spec",
+		"",
+		"[optional] genesis builder spec as JSON string, to build the genesis from instead of using the default one",
+	)
+	cmd.PersistentFlags().StringVar(
+		&cChainAllocationsPath,
+		"c-chain-allocations",
+		"",
+		"[optional] path of a JSON file of accounts added to the C-Chain genesis, in the format of the alloc of a geth genesis",
+	)
+	cmd.PersistentFlags().StringVar(
+		&cChainArtifacts,
+		"c-chain-artifacts",
+		"",
+		"[optional] JSON string of map that maps from C-Chain address to the path of a foundry or hardhat artifact, whose contract is deployed at the address from the genesis",
+	)
+	cmd.PersistentFlags().StringVar(
+		&specPath,
+		"spec",
+		"",
+		"[optional] path of a YAML or JSON network spec to create the network from, replacing the options of the nodes, genesis, plugin dir, custom VMs, whitelisted subnets and log level",
+	)
 	return cmd
 }
 
 func startFunc(cmd *cobra.Command, args []string) error {
-	cli, err := client.New(client.Config{
-		LogLevel:    logLevel,
-		Endpoint:    endpoint,
-		DialTimeout: dialTimeout,
-	})
+	cli, err := newClient()
 	if err != nil {
 		return err
 	}
@@ -131,8 +241,13 @@ func startFunc(cmd *cobra.Command, args []string) error {
 
 	opts := []client.OpOption{
 		client.WithNumNodes(numNodes),
+		client.WithNumBeacons(numBeacons),
 		client.WithPluginDir(pluginDir),
 		client.WithWhitelistedSubnets(whitelistedSubnets),
+		client.WithP2PProxy(p2pProxy),
+		client.WithDistinctNodeIPs(distinctNodeIPs),
+		client.WithIdentitySeed(identitySeed),
+		client.WithGenesisSpec(genesisSpec),
 	}
 
 	if globalNodeConfig != "" {
@@ -162,6 +277,27 @@ func startFunc(cmd *cobra.Command, args []string) error {
 		opts = append(opts, client.WithCustomVMs(customVMs))
 	}
 
+	if specPath != "" {
+		// the spec is checked here, so that its errors name the file
+		networkSpec, err := spec.Load(specPath)
+		if err != nil {
+			return err
+		}
+		specBytes, err := json.Marshal(networkSpec)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, client.WithSpec(string(specBytes)))
+	}
+
+	if cChainAllocationsPath != "" || cChainArtifacts != "" {
+		allocJSON, err := loadCChainAllocations()
+		if err != nil {
+			return err
+		}
+		opts = append(opts, client.WithCChainAllocations(allocJSON))
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
 	// don't call since "start" is async
 	// and the top-level context here "ctx" is passed
@@ -183,21 +319,55 @@ func startFunc(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// loadCChainAllocations returns the accounts of the C-Chain genesis
+// given by [cChainAllocationsPath] and [cChainArtifacts], as the JSON
+// sent to the server. Artifacts replace accounts of the same address.
+func loadCChainAllocations() (string, error) {
+	var allocations []network.CChainAllocation
+	if cChainAllocationsPath != "" {
+		var err error
+		allocations, err = network.LoadCChainAllocations(cChainAllocationsPath)
+		if err != nil {
+			return "", err
+		}
+	}
+	if cChainArtifacts != "" {
+		artifactPaths := make(map[string]string)
+		if err := json.Unmarshal([]byte(cChainArtifacts), &artifactPaths); err != nil {
+			return "", err
+		}
+		for addr, path := range artifactPaths {
+			allocation, err := network.LoadCChainArtifact(path, addr)
+			if err != nil {
+				return "", err
+			}
+			allocations = append(allocations, allocation)
+		}
+	}
+	allocBytes, err := network.MarshalCChainAllocations(allocations)
+	if err != nil {
+		return "", err
+	}
+	return string(allocBytes), nil
+}
+
 func newHealthCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "health [options]",
 		Short: "Requests server health.",
 		RunE:  healthFunc,
 	}
+	cmd.PersistentFlags().StringSliceVar(
+		&nodeNames,
+		"node-names",
+		nil,
+		"comma separated node names to wait for; all if empty",
+	)
 	return cmd
 }
 
 func healthFunc(cmd *cobra.Command, args []string) error {
-	cli, err := client.New(client.Config{
-		LogLevel:    logLevel,
-		Endpoint:    endpoint,
-		DialTimeout: dialTimeout,
-	})
+	cli, err := newClient()
 	if err != nil {
 		return err
 	}
@@ -205,7 +375,7 @@ func healthFunc(cmd *cobra.Command, args []string) error {
 	defer cli.Close()
 
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
-	resp, err := cli.Health(ctx)
+	resp, err := cli.Health(ctx, nodeNames...)
 	cancel()
 	if err != nil {
 		return err
@@ -225,11 +395,7 @@ func newURIsCommand() *cobra.Command {
 }
 
 func urisFunc(cmd *cobra.Command, args []string) error {
-	cli, err := client.New(client.Config{
-		LogLevel:    logLevel,
-		Endpoint:    endpoint,
-		DialTimeout: dialTimeout,
-	})
+	cli, err := newClient()
 	if err != nil {
 		return err
 	}
@@ -256,11 +422,7 @@ func newStatusCommand() *cobra.Command {
 }
 
 func statusFunc(cmd *cobra.Command, args []string) error {
-	cli, err := client.New(client.Config{
-		LogLevel:    logLevel,
-		Endpoint:    endpoint,
-		DialTimeout: dialTimeout,
-	})
+	cli, err := newClient()
 	if err != nil {
 		return err
 	}
@@ -295,11 +457,7 @@ func newStreamStatusCommand() *cobra.Command {
 }
 
 func streamStatusFunc(cmd *cobra.Command, args []string) error {
-	cli, err := client.New(client.Config{
-		LogLevel:    logLevel,
-		Endpoint:    endpoint,
-		DialTimeout: dialTimeout,
-	})
+	cli, err := newClient()
 	if err != nil {
 		return err
 	}
@@ -333,6 +491,261 @@ func streamStatusFunc(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var (
+	eventTypes []string
+	nodeNames  []string
+)
+
+func newEventsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "events [options]",
+		Short: "Watches network events (e.g. nodes added, exited, healthy).",
+		RunE:  eventsFunc,
+	}
+	cmd.PersistentFlags().StringSliceVar(
+		&eventTypes,
+		"event-types",
+		nil,
+		"comma separated event types to watch (e.g. node-exited,node-unhealthy); all if empty",
+	)
+	cmd.PersistentFlags().StringSliceVar(
+		&nodeNames,
+		"node-names",
+		nil,
+		"comma separated node names to watch events of; all if empty",
+	)
+	return cmd
+}
+
+func eventsFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	// watch until timeout or os signal
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+
+	donec := make(chan struct{})
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	go func() {
+		select {
+		case sig := <-sigc:
+			zap.L().Warn("received signal", zap.String("signal", sig.String()))
+		case <-ctx.Done():
+		}
+		cancel()
+		close(donec)
+	}()
+
+	ch, err := cli.WatchEvents(ctx, eventTypes, nodeNames)
+	if err != nil {
+		return err
+	}
+	for event := range ch {
+		color.Outf(
+			"{{cyan}}%s{{/}} {{green}}%s{{/}} %s %s\n",
+			time.Unix(0, event.Timestamp).Format(time.RFC3339Nano),
+			event.Type,
+			event.NodeName,
+			event.Message,
+		)
+	}
+	cancel() // receiver channel is closed, so cancel goroutine
+	<-donec
+	return nil
+}
+
+var (
+	logSources    []string
+	logsFollow    bool
+	logsTail      uint32
+	logsLevel     string
+	logsRegex     string
+	logsNodeNames []string
+)
+
+func newLogsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs [options]",
+		Short: "Prints the logs of nodes: the output of their processes or their avalanchego log files.",
+		RunE:  logsFunc,
+	}
+	cmd.PersistentFlags().StringSliceVar(
+		&logsNodeNames,
+		"node",
+		nil,
+		"comma separated node names to print the logs of; all if empty",
+	)
+	cmd.PersistentFlags().StringSliceVar(
+		&logSources,
+		"sources",
+		nil,
+		"comma separated logs to print: stdout, stderr, or an avalanchego log file name without extension (e.g. main,C); stdout and stderr if empty",
+	)
+	cmd.PersistentFlags().BoolVarP(&logsFollow, "follow", "f", false, "true to print the lines appended to the logs until interrupted")
+	cmd.PersistentFlags().Uint32Var(&logsTail, "tail", 0, "number of last lines of each log printed before following; all if 0")
+	cmd.PersistentFlags().StringVar(&logsLevel, "level", "", "only print lines of this level or a more severe one (e.g. WARN)")
+	cmd.PersistentFlags().StringVar(&logsRegex, "regex", "", "only print lines matching this regular expression")
+	return cmd
+}
+
+func logsFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	// print until the end of the logs, or when following, until os signal
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+
+	donec := make(chan struct{})
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+	if logsFollow {
+		ctx, cancel = context.WithCancel(context.Background())
+	} else {
+		ctx, cancel = context.WithTimeout(context.Background(), requestTimeout)
+	}
+	go func() {
+		select {
+		case sig := <-sigc:
+			zap.L().Warn("received signal", zap.String("signal", sig.String()))
+		case <-ctx.Done():
+		}
+		cancel()
+		close(donec)
+	}()
+
+	ch, err := cli.StreamLogs(
+		ctx,
+		client.WithNodeNames(logsNodeNames),
+		client.WithLogSources(logSources),
+		client.WithFollow(logsFollow),
+		client.WithTailLines(logsTail),
+		client.WithMinLogLevel(logsLevel),
+		client.WithLogRegex(logsRegex),
+	)
+	if err != nil {
+		cancel()
+		<-donec
+		return err
+	}
+	for line := range ch {
+		// the line isn't given to color.Outf, which would parse it as a template
+		fmt.Printf("[%s %s] %s\n", line.NodeName, line.Source, line.Line)
+	}
+	cancel() // receiver channel is closed, so cancel goroutine
+	<-donec
+	return nil
+}
+
+var (
+	topNodeNames []string
+	topInterval  time.Duration
+)
+
+func newTopCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top [options]",
+		Short: "Shows a live view of the resources used by the nodes.",
+		RunE:  topFunc,
+	}
+	cmd.PersistentFlags().StringSliceVar(
+		&topNodeNames,
+		"node",
+		nil,
+		"comma separated node names to show; all if empty",
+	)
+	cmd.PersistentFlags().DurationVar(&topInterval, "interval", 5*time.Second, "interval between refreshes")
+	return cmd
+}
+
+func topFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	// refresh until os signal
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		resp, err := cli.GetResourceUsage(ctx, topNodeNames...)
+		cancel()
+		if err != nil {
+			return err
+		}
+		printResourceUsage(resp.NodeResources)
+
+		select {
+		case sig := <-sigc:
+			zap.L().Warn("received signal", zap.String("signal", sig.String()))
+			return nil
+		case <-time.After(topInterval):
+		}
+	}
+}
+
+// printResourceUsage clears the terminal and prints a table
+// of the current resources used by each node of [nodeResources]
+func printResourceUsage(nodeResources map[string]*rpcpb.NodeResources) {
+	names := make([]string, 0, len(nodeResources))
+	for name := range nodeResources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// move the cursor home and clear the screen
+	fmt.Print("\033[H\033[2J")
+	color.Outf("{{green}}resource usage at %s{{/}}\n\n", time.Now().Format(time.RFC3339))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "NODE\tCPU%\tCPU TIME\tRSS\tTHREADS\tFDS\tREAD\tWRITE\tDB DIR\tLOG DIR\t")
+	for _, name := range names {
+		usage := nodeResources[name].GetCurrent()
+		if usage == nil {
+			fmt.Fprintf(w, "%s\t-\t-\t-\t-\t-\t-\t-\t-\t-\t\n", name)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%.1f\t%s\t%s\t%d\t%d\t%s\t%s\t%s\t%s\t\n",
+			name,
+			usage.CpuPercent,
+			time.Duration(usage.CpuSeconds*float64(time.Second)).Round(time.Second),
+			formatBytes(usage.RssBytes),
+			usage.Threads,
+			usage.OpenFds,
+			formatBytes(usage.ReadBytes),
+			formatBytes(usage.WriteBytes),
+			formatBytes(usage.DbDirBytes),
+			formatBytes(usage.LogDirBytes),
+		)
+	}
+	_ = w.Flush()
+}
+
+// formatBytes returns [b] in a human readable unit, e.g. "1.5 MiB"
+func formatBytes(b uint64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := uint64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
+
 var nodeName string
 
 func newRemoveNodeCommand() *cobra.Command {
@@ -346,11 +759,7 @@ func newRemoveNodeCommand() *cobra.Command {
 }
 
 func removeNodeFunc(cmd *cobra.Command, args []string) error {
-	cli, err := client.New(client.Config{
-		LogLevel:    logLevel,
-		Endpoint:    endpoint,
-		DialTimeout: dialTimeout,
-	})
+	cli, err := newClient()
 	if err != nil {
 		return err
 	}
@@ -403,15 +812,23 @@ func newAddNodeCommand() *cobra.Command {
 		"",
 		"node config as string",
 	)
+	cmd.PersistentFlags().StringVar(
+		&identitySeed,
+		"identity-seed",
+		"",
+		"[optional] seed of the staking identity of the node, instead of the one of the network",
+	)
+	cmd.PersistentFlags().StringSliceVar(
+		&bootstrapNodes,
+		"bootstrap-nodes",
+		nil,
+		"[optional] nodes the node bootstraps from, instead of the beacons",
+	)
 	return cmd
 }
 
 func addNodeFunc(cmd *cobra.Command, args []string) error {
-	cli, err := client.New(client.Config{
-		LogLevel:    logLevel,
-		Endpoint:    endpoint,
-		DialTimeout: dialTimeout,
-	})
+	cli, err := newClient()
 	if err != nil {
 		return err
 	}
@@ -419,6 +836,8 @@ func addNodeFunc(cmd *cobra.Command, args []string) error {
 
 	opts := []client.OpOption{
 		client.WithLogLevel(logLevel),
+		client.WithIdentitySeed(identitySeed),
+		client.WithBootstrapNodes(bootstrapNodes),
 	}
 
 	if addNodeConfig != "" {
@@ -484,11 +903,7 @@ func newRestartNodeCommand() *cobra.Command {
 }
 
 func restartNodeFunc(cmd *cobra.Command, args []string) error {
-	cli, err := client.New(client.Config{
-		LogLevel:    logLevel,
-		Endpoint:    endpoint,
-		DialTimeout: dialTimeout,
-	})
+	cli, err := newClient()
 	if err != nil {
 		return err
 	}
@@ -505,136 +920,729 @@ func restartNodeFunc(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func newAttachPeerCommand() *cobra.Command {
+var (
+	upgradeBatchSize     uint32
+	upgradeHealthTimeout time.Duration
+	upgradeRollback      bool
+)
+
+func newRollingUpgradeCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "attach-peer [options]",
-		Short: "Attaches a peer to the node.",
-		RunE:  attachPeerFunc,
+		Use:   "rolling-upgrade [options]",
+		Short: "Restarts nodes on a new binary, batch by batch, waiting for each batch to be healthy.",
+		RunE:  rollingUpgradeFunc,
 	}
 	cmd.PersistentFlags().StringVar(
-		&nodeName,
-		"node-name",
+		&avalancheGoBinPath,
+		"avalanchego-path",
 		"",
-		"node name to attach a peer to",
+		"avalanchego binary path to upgrade to",
+	)
+	cmd.PersistentFlags().StringSliceVar(
+		&nodeNames,
+		"node-names",
+		nil,
+		"comma separated node names to upgrade, in order; all if empty",
+	)
+	cmd.PersistentFlags().Uint32Var(&upgradeBatchSize, "batch-size", 1, "number of nodes upgraded at once")
+	cmd.PersistentFlags().DurationVar(
+		&upgradeHealthTimeout,
+		"health-timeout",
+		0,
+		"time to wait for each batch to be healthy (0 for the default)",
+	)
+	cmd.PersistentFlags().BoolVar(
+		&upgradeRollback,
+		"rollback",
+		false,
+		"true to restart the upgraded nodes on their previous binary if the upgrade fails",
 	)
 	return cmd
 }
 
-func attachPeerFunc(cmd *cobra.Command, args []string) error {
-	cli, err := client.New(client.Config{
-		LogLevel:    logLevel,
-		Endpoint:    endpoint,
-		DialTimeout: dialTimeout,
-	})
+func rollingUpgradeFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
 	if err != nil {
 		return err
 	}
 	defer cli.Close()
 
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
-	resp, err := cli.AttachPeer(ctx, nodeName)
+	info, err := cli.RollingUpgrade(
+		ctx,
+		avalancheGoBinPath,
+		client.WithNodeNames(nodeNames),
+		client.WithBatchSize(upgradeBatchSize),
+		client.WithHealthTimeout(upgradeHealthTimeout),
+		client.WithRollback(upgradeRollback),
+	)
 	cancel()
 	if err != nil {
 		return err
 	}
 
-	color.Outf("{{green}}attach peer response:{{/}} %+v\n", resp)
+	color.Outf("{{green}}rolling upgrade response:{{/}} %+v\n", info)
 	return nil
 }
 
-var (
-	peerID      string
-	msgOp       uint32
-	msgBytesB64 string
-)
+var applyDryRun bool
 
-func newSendOutboundMessageCommand() *cobra.Command {
+func newApplyCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "send-outbound-message [options]",
-		Short: "Sends an outbound message to an attached peer.",
-		RunE:  sendOutboundMessageFunc,
+		Use:   "apply [options]",
+		Short: "Changes the nodes of the network to the ones of a network spec, adding, removing and restarting nodes.",
+		RunE:  applyFunc,
 	}
 	cmd.PersistentFlags().StringVar(
-		&nodeName,
-		"node-name",
-		"",
-		"node name that has an attached peer",
-	)
-	cmd.PersistentFlags().StringVar(
-		&peerID,
-		"peer-id",
+		&specPath,
+		"spec",
 		"",
-		"peer ID to send a message to",
-	)
-	cmd.PersistentFlags().Uint32Var(
-		&msgOp,
-		"message-op",
-		0,
-		"Message operation type",
+		"path of the YAML or JSON network spec to apply",
 	)
-	cmd.PersistentFlags().StringVar(
-		&msgBytesB64,
-		"message-bytes-b64",
-		"",
-		"Message bytes in base64 encoding",
+	cmd.PersistentFlags().BoolVar(
+		&applyDryRun,
+		"dry-run",
+		false,
+		"true to print the steps of the apply without executing them",
 	)
 	return cmd
 }
 
-func sendOutboundMessageFunc(cmd *cobra.Command, args []string) error {
-	cli, err := client.New(client.Config{
-		LogLevel:    logLevel,
-		Endpoint:    endpoint,
-		DialTimeout: dialTimeout,
-	})
+func applyFunc(cmd *cobra.Command, args []string) error {
+	networkSpec, err := spec.Load(specPath)
+	if err != nil {
+		return err
+	}
+	specBytes, err := json.Marshal(networkSpec)
 	if err != nil {
 		return err
 	}
-	defer cli.Close()
 
-	b, err := base64.StdEncoding.DecodeString(msgBytesB64)
+	cli, err := newClient()
 	if err != nil {
 		return err
 	}
+	defer cli.Close()
 
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
-	resp, err := cli.SendOutboundMessage(ctx, nodeName, peerID, msgOp, b)
+	info, err := cli.Apply(ctx, string(specBytes), client.WithDryRun(applyDryRun))
 	cancel()
 	if err != nil {
 		return err
 	}
 
-	color.Outf("{{green}}send outbound message response:{{/}} %+v\n", resp)
+	for _, step := range info.Steps {
+		switch step.Status {
+		case "failed":
+			color.Outf("{{red}}%s %s (%s): %s{{/}}\n", step.Action, step.NodeName, step.Reason, step.Error)
+		default:
+			color.Outf("{{cyan}}%s %s (%s): %s{{/}}\n", step.Action, step.NodeName, step.Reason, step.Status)
+		}
+	}
+	color.Outf("{{green}}apply response:{{/}} %+v\n", info)
 	return nil
 }
 
-func newStopCommand() *cobra.Command {
+func newPauseNodeCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "stop [options]",
-		Short: "Requests server stop.",
-		RunE:  stopFunc,
+		Use:   "pause-node [options]",
+		Short: "Pauses a node (SIGSTOP) without terminating it.",
+		RunE:  pauseNodeFunc,
 	}
+	cmd.PersistentFlags().StringVar(&nodeName, "node-name", "", "node name to pause")
 	return cmd
 }
 
-func stopFunc(cmd *cobra.Command, args []string) error {
-	cli, err := client.New(client.Config{
-		LogLevel:    logLevel,
-		Endpoint:    endpoint,
-		DialTimeout: dialTimeout,
-	})
+func pauseNodeFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
 	if err != nil {
 		return err
 	}
 	defer cli.Close()
 
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
-	info, err := cli.Stop(ctx)
+	info, err := cli.PauseNode(ctx, nodeName)
 	cancel()
 	if err != nil {
 		return err
 	}
 
-	color.Outf("{{green}}stop response:{{/}} %+v\n", info)
+	color.Outf("{{green}}pause node response:{{/}} %+v\n", info)
+	return nil
+}
+
+func newResumeNodeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-node [options]",
+		Short: "Resumes a paused node (SIGCONT).",
+		RunE:  resumeNodeFunc,
+	}
+	cmd.PersistentFlags().StringVar(&nodeName, "node-name", "", "node name to resume")
+	return cmd
+}
+
+func resumeNodeFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.ResumeNode(ctx, nodeName)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}resume node response:{{/}} %+v\n", info)
+	return nil
+}
+
+var isBeacon bool
+
+func newSetBeaconCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-beacon [options]",
+		Short: "Makes a node a beacon of the nodes added later, or not.",
+		RunE:  setBeaconFunc,
+	}
+	cmd.PersistentFlags().StringVar(&nodeName, "node-name", "", "node name to promote or demote")
+	cmd.PersistentFlags().BoolVar(&isBeacon, "beacon", true, "false to demote the node")
+	return cmd
+}
+
+func setBeaconFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.SetBeacon(ctx, nodeName, isBeacon)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}set beacon response:{{/}} %+v\n", info)
+	return nil
+}
+
+var partitionGroups []string
+
+func newCreatePartitionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-partition [options]",
+		Short: "Partitions the network, blocking the traffic between nodes of different groups.",
+		RunE:  createPartitionFunc,
+	}
+	cmd.PersistentFlags().StringArrayVar(
+		&partitionGroups,
+		"group",
+		nil,
+		"comma separated node names of a group; repeat for each group",
+	)
+	return cmd
+}
+
+func createPartitionFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	groups := make([][]string, len(partitionGroups))
+	for i, group := range partitionGroups {
+		groups[i] = strings.Split(group, ",")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.CreatePartition(ctx, groups)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}create partition response:{{/}} %+v\n", info)
+	return nil
+}
+
+func newHealPartitionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "heal-partition [options]",
+		Short: "Heals the network partition.",
+		RunE:  healPartitionFunc,
+	}
+	return cmd
+}
+
+func healPartitionFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.HealPartition(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}heal partition response:{{/}} %+v\n", info)
+	return nil
+}
+
+var (
+	peerName             string
+	faultDelay           time.Duration
+	faultJitter          time.Duration
+	faultBandwidth       uint64
+	faultDropProbability float64
+)
+
+func newSetFaultsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-faults [options]",
+		Short: "Injects faults in the P2P traffic of a node, or between two nodes. Zero faults remove them.",
+		RunE:  setFaultsFunc,
+	}
+	cmd.PersistentFlags().StringVar(&nodeName, "node-name", "", "node name to inject faults in the traffic of")
+	cmd.PersistentFlags().StringVar(
+		&peerName,
+		"peer-name",
+		"",
+		"if set, faults are injected only in the traffic between --node-name and this node",
+	)
+	cmd.PersistentFlags().DurationVar(&faultDelay, "delay", 0, "delay of the traffic")
+	cmd.PersistentFlags().DurationVar(&faultJitter, "jitter", 0, "random variation of the delay, up to this much either way")
+	cmd.PersistentFlags().Uint64Var(&faultBandwidth, "bandwidth", 0, "max throughput in bytes per second (0 for no limit)")
+	cmd.PersistentFlags().Float64Var(&faultDropProbability, "drop-probability", 0, "probability that a new connection is dropped")
+	return cmd
+}
+
+func setFaultsFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	faults := &rpcpb.LinkFaults{
+		DelayMs:         uint64(faultDelay.Milliseconds()),
+		JitterMs:        uint64(faultJitter.Milliseconds()),
+		Bandwidth:       faultBandwidth,
+		DropProbability: faultDropProbability,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.SetFaults(ctx, nodeName, peerName, faults)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}set faults response:{{/}} %+v\n", info)
+	return nil
+}
+
+func newAttachPeerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attach-peer [options]",
+		Short: "Attaches a peer to the node.",
+		RunE:  attachPeerFunc,
+	}
+	cmd.PersistentFlags().StringVar(
+		&nodeName,
+		"node-name",
+		"",
+		"node name to attach a peer to",
+	)
+	return cmd
+}
+
+func attachPeerFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.AttachPeer(ctx, nodeName)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}attach peer response:{{/}} %+v\n", resp)
+	return nil
+}
+
+var (
+	peerID      string
+	msgOp       uint32
+	msgBytesB64 string
+)
+
+func newSendOutboundMessageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-outbound-message [options]",
+		Short: "Sends an outbound message to an attached peer.",
+		RunE:  sendOutboundMessageFunc,
+	}
+	cmd.PersistentFlags().StringVar(
+		&nodeName,
+		"node-name",
+		"",
+		"node name that has an attached peer",
+	)
+	cmd.PersistentFlags().StringVar(
+		&peerID,
+		"peer-id",
+		"",
+		"peer ID to send a message to",
+	)
+	cmd.PersistentFlags().Uint32Var(
+		&msgOp,
+		"message-op",
+		0,
+		"Message operation type",
+	)
+	cmd.PersistentFlags().StringVar(
+		&msgBytesB64,
+		"message-bytes-b64",
+		"",
+		"Message bytes in base64 encoding",
+	)
+	return cmd
+}
+
+func sendOutboundMessageFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	b, err := base64.StdEncoding.DecodeString(msgBytesB64)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.SendOutboundMessage(ctx, nodeName, peerID, msgOp, b)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}send outbound message response:{{/}} %+v\n", resp)
+	return nil
+}
+
+func newStopCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stop [options]",
+		Short: "Requests server stop.",
+		RunE:  stopFunc,
+	}
+	return cmd
+}
+
+func stopFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.Stop(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}stop response:{{/}} %+v\n", info)
+	return nil
+}
+
+var (
+	snapshotName string
+	rootDataDir  string
+)
+
+func newSaveSnapshotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save-snapshot [options]",
+		Short: "Stops the network and saves its state as a snapshot.",
+		RunE:  saveSnapshotFunc,
+	}
+	cmd.PersistentFlags().StringVar(
+		&snapshotName,
+		"snapshot-name",
+		"",
+		"name of the snapshot to save",
+	)
+	return cmd
+}
+
+func saveSnapshotFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	snapshotPath, err := cli.SaveSnapshot(ctx, snapshotName)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}save snapshot response:{{/}} %q\n", snapshotPath)
+	return nil
+}
+
+func newLoadSnapshotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "load-snapshot [options]",
+		Short: "Starts a network from a snapshot.",
+		RunE:  loadSnapshotFunc,
+	}
+	cmd.PersistentFlags().StringVar(
+		&snapshotName,
+		"snapshot-name",
+		"",
+		"name of the snapshot to load",
+	)
+	cmd.PersistentFlags().StringVar(
+		&avalancheGoBinPath,
+		"avalanchego-path",
+		"",
+		"[optional] avalanchego binary path, overrides the one saved in the snapshot",
+	)
+	cmd.PersistentFlags().StringVar(
+		&rootDataDir,
+		"root-data-dir",
+		"",
+		"[optional] root data directory for the databases and logs of the nodes",
+	)
+	return cmd
+}
+
+func loadSnapshotFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.LoadSnapshot(
+		ctx,
+		snapshotName,
+		client.WithExecPath(avalancheGoBinPath),
+		client.WithRootDataDir(rootDataDir),
+	)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}load snapshot response:{{/}} %+v\n", resp)
+	return nil
+}
+
+func newRemoveSnapshotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-snapshot [options]",
+		Short: "Removes a snapshot.",
+		RunE:  removeSnapshotFunc,
+	}
+	cmd.PersistentFlags().StringVar(
+		&snapshotName,
+		"snapshot-name",
+		"",
+		"name of the snapshot to remove",
+	)
+	return cmd
+}
+
+func removeSnapshotFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	err = cli.RemoveSnapshot(ctx, snapshotName)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}removed snapshot:{{/}} %q\n", snapshotName)
+	return nil
+}
+
+func newListSnapshotsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-snapshots [options]",
+		Short: "Lists the available snapshots.",
+		RunE:  listSnapshotsFunc,
+	}
+	return cmd
+}
+
+func listSnapshotsFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	snapshotNames, err := cli.ListSnapshots(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}snapshots:{{/}} %q\n", snapshotNames)
+	return nil
+}
+
+func newListNetworksCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-networks [options]",
+		Short: "Lists the started networks of the server.",
+		RunE:  listNetworksFunc,
+	}
+	return cmd
+}
+
+func listNetworksFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.ListNetworks(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range resp.NetworkNames {
+		info := resp.ClusterInfos[name]
+		color.Outf("{{green}}%s:{{/}} root data dir %s, %d nodes, healthy %v\n", name, info.RootDataDir, len(info.NodeNames), info.Healthy)
+	}
+	return nil
+}
+
+func newStopAllCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stop-all [options]",
+		Short: "Stops all the networks of the server.",
+		RunE:  stopAllFunc,
+	}
+	return cmd
+}
+
+func stopAllFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	networkNames, err := cli.StopAll(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}stopped networks:{{/}} %q\n", networkNames)
+	return nil
+}
+
+func newResumeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume [options]",
+		Short: "Resumes a network left running by a server that exited, adopting its running nodes.",
+		RunE:  resumeFunc,
+	}
+	cmd.PersistentFlags().StringVar(
+		&rootDataDir,
+		"root-data-dir",
+		"",
+		"root data directory of the network to resume",
+	)
+	return cmd
+}
+
+func resumeFunc(cmd *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.Resume(ctx, rootDataDir)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}resume response:{{/}} %+v\n", resp)
+	return nil
+}
+
+var (
+	cleanupRootDirs []string
+	cleanupDryRun   bool
+)
+
+func newCleanupCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cleanup [options]",
+		Short: "Kills the nodes left running by servers that exited without stopping them. Runs on this host, without the server.",
+		RunE:  cleanupFunc,
+	}
+	cmd.PersistentFlags().StringSliceVar(
+		&cleanupRootDirs,
+		"root-dirs",
+		nil,
+		"comma separated root data dirs of networks to clean up, besides the ones created in the temporary dir",
+	)
+	cmd.PersistentFlags().BoolVar(&cleanupDryRun, "dry-run", false, "true to only print the nodes left running")
+	return cmd
+}
+
+func cleanupFunc(cmd *cobra.Command, args []string) error {
+	orphans, err := server.ReapOrphans(cleanupRootDirs, nil, cleanupDryRun)
+	for _, orphan := range orphans {
+		action := "killed"
+		if cleanupDryRun {
+			action = "found"
+		}
+		color.Outf("{{green}}%s orphan node:{{/}} %s (pid %d, root dir %s, binary %s)\n", action, orphan.NodeName, orphan.PID, orphan.RootDir, orphan.BinaryPath)
+	}
+	if err != nil {
+		return err
+	}
+	color.Outf("{{green}}orphan nodes:{{/}} %d\n", len(orphans))
 	return nil
 }
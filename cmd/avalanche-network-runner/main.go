@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/ava-labs/avalanche-network-runner/cmd/avalanche-network-runner/agent"
 	"github.com/ava-labs/avalanche-network-runner/cmd/avalanche-network-runner/control"
 	"github.com/ava-labs/avalanche-network-runner/cmd/avalanche-network-runner/ping"
 	"github.com/ava-labs/avalanche-network-runner/cmd/avalanche-network-runner/server"
@@ -28,6 +29,7 @@ func init() {
 		server.NewCommand(),
 		ping.NewCommand(),
 		control.NewCommand(),
+		agent.NewCommand(),
 	)
 }
 
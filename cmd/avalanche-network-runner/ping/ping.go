@@ -5,6 +5,7 @@ package ping
 
 import (
 	"context"
+	"os"
 	"time"
 
 	"github.com/ava-labs/avalanche-network-runner/client"
@@ -18,6 +19,13 @@ var (
 	endpoint       string
 	dialTimeout    time.Duration
 	requestTimeout time.Duration
+
+	tlsEnabled    bool
+	tlsCAFile     string
+	tlsCertFile   string
+	tlsKeyFile    string
+	tlsServerName string
+	authToken     string
 )
 
 func NewCommand() *cobra.Command {
@@ -31,15 +39,27 @@ func NewCommand() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&endpoint, "endpoint", "0.0.0.0:8080", "server endpoint")
 	cmd.PersistentFlags().DurationVar(&dialTimeout, "dial-timeout", 10*time.Second, "server dial timeout")
 	cmd.PersistentFlags().DurationVar(&requestTimeout, "request-timeout", 10*time.Second, "client request timeout")
+	cmd.PersistentFlags().BoolVar(&tlsEnabled, "tls", false, "[optional] true to dial the server over TLS (implied by the other TLS flags)")
+	cmd.PersistentFlags().StringVar(&tlsCAFile, "tls-ca-file", "", "[optional] PEM file of the CAs to verify the server cert with (system CAs if empty)")
+	cmd.PersistentFlags().StringVar(&tlsCertFile, "tls-cert-file", "", "[optional] client cert file, for servers that require one (mTLS)")
+	cmd.PersistentFlags().StringVar(&tlsKeyFile, "tls-key-file", "", "[optional] client key file, for servers that require a cert (mTLS)")
+	cmd.PersistentFlags().StringVar(&tlsServerName, "tls-server-name", "", "[optional] name to verify the server cert for, instead of the endpoint host")
+	cmd.PersistentFlags().StringVar(&authToken, "auth-token", os.Getenv("NETWORK_RUNNER_AUTH_TOKEN"), "[optional] bearer token sent with every request (defaults to $NETWORK_RUNNER_AUTH_TOKEN)")
 
 	return cmd
 }
 
 func pingFunc(cmd *cobra.Command, args []string) error {
 	cli, err := client.New(client.Config{
-		LogLevel:    logLevel,
-		Endpoint:    endpoint,
-		DialTimeout: dialTimeout,
+		LogLevel:      logLevel,
+		Endpoint:      endpoint,
+		DialTimeout:   dialTimeout,
+		TLS:           tlsEnabled,
+		TLSCAFile:     tlsCAFile,
+		TLSCertFile:   tlsCertFile,
+		TLSKeyFile:    tlsKeyFile,
+		TLSServerName: tlsServerName,
+		AuthToken:     authToken,
 	})
 	if err != nil {
 		return err
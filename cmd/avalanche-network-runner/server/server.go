@@ -26,6 +26,18 @@ var (
 	port        string
 	gwPort      string
 	dialTimeout time.Duration
+
+	snapshotsDir    string
+	metricsPort     string
+	stopGracePeriod time.Duration
+	reapOrphans     bool
+	resume          []string
+
+	tlsCertFile        string
+	tlsKeyFile         string
+	tlsClientCAFile    string
+	authTokens         []string
+	readOnlyAuthTokens []string
 )
 
 func NewCommand() *cobra.Command {
@@ -39,6 +51,16 @@ func NewCommand() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&port, "port", ":8080", "server port")
 	cmd.PersistentFlags().StringVar(&gwPort, "grpc-gateway-port", ":8081", "grpc-gateway server port")
 	cmd.PersistentFlags().DurationVar(&dialTimeout, "dial-timeout", 10*time.Second, "server dial timeout")
+	cmd.PersistentFlags().StringVar(&snapshotsDir, "snapshots-dir", server.DefaultSnapshotsDir, "directory for network snapshots")
+	cmd.PersistentFlags().StringVar(&metricsPort, "metrics-port", "", "[optional] port to also serve the metrics of the runner and its nodes on, at /metrics (e.g. :9090)")
+	cmd.PersistentFlags().DurationVar(&stopGracePeriod, "stop-grace-period", 0, "[optional] time a node is given to exit once sent a SIGTERM, before it's sent a SIGKILL (10s if 0)")
+	cmd.PersistentFlags().BoolVar(&reapOrphans, "reap-orphans", false, "[optional] true to kill the nodes left running by previous servers before starting")
+	cmd.PersistentFlags().StringSliceVar(&resume, "resume", nil, "[optional] comma separated root data dirs of networks left running by previous servers, to resume before serving")
+	cmd.PersistentFlags().StringVar(&tlsCertFile, "tls-cert-file", "", "[optional] cert file to serve gRPC and the gateway over TLS with")
+	cmd.PersistentFlags().StringVar(&tlsKeyFile, "tls-key-file", "", "[optional] key file of the TLS cert")
+	cmd.PersistentFlags().StringVar(&tlsClientCAFile, "tls-client-ca-file", "", "[optional] PEM file of the CAs that must sign the client certs (mTLS)")
+	cmd.PersistentFlags().StringSliceVar(&authTokens, "auth-tokens", nil, "[optional] bearer tokens, one of which the requests must carry")
+	cmd.PersistentFlags().StringSliceVar(&readOnlyAuthTokens, "read-only-auth-tokens", nil, "[optional] bearer tokens that can only call status, health, uris, stream-status and list-networks")
 
 	return cmd
 }
@@ -52,10 +74,36 @@ func serverFunc(cmd *cobra.Command, args []string) (err error) {
 	}
 	_ = zap.ReplaceGlobals(logger)
 
+	if reapOrphans {
+		// the nodes of the resumed networks are adopted instead
+		orphans, err := server.ReapOrphans(nil, resume, false)
+		for _, orphan := range orphans {
+			zap.L().Warn("killed orphan node",
+				zap.String("node-name", orphan.NodeName),
+				zap.Int("pid", orphan.PID),
+				zap.String("root-dir", orphan.RootDir),
+			)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
 	s, err := server.New(server.Config{
-		Port:        port,
-		GwPort:      gwPort,
-		DialTimeout: dialTimeout,
+		Port:            port,
+		GwPort:          gwPort,
+		DialTimeout:     dialTimeout,
+		SnapshotsDir:    snapshotsDir,
+		MetricsPort:     metricsPort,
+		StopGracePeriod: stopGracePeriod,
+
+		ResumeRootDataDirs: resume,
+
+		TLSCertFile:        tlsCertFile,
+		TLSKeyFile:         tlsKeyFile,
+		TLSClientCAFile:    tlsClientCAFile,
+		AuthTokens:         authTokens,
+		ReadOnlyAuthTokens: readOnlyAuthTokens,
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,223 @@
+package local
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+const (
+	// Seed of the staking identities of the nodes
+	// added by NewDefaultConfigNNodes past the default ones
+	DefaultIdentitySeed = "avalanche-network-runner"
+	// Name of the file WriteStakingIdentities writes the node IDs to
+	NodeIDsFileName = "node-ids.json"
+	// Size, in bits, of the staking keys, as generated by avalanchego
+	stakingKeySize = 4096
+	// Public exponent of the staking keys
+	stakingKeyExponent = 65537
+)
+
+// Validity period of the staking certs. Unlike avalanchego,
+// the end doesn't depend on the current time, so certs are reproducible.
+var (
+	stakingCertNotBefore = time.Date(2000, time.January, 0, 0, 0, 0, 0, time.UTC)
+	stakingCertNotAfter  = time.Date(2100, time.January, 0, 0, 0, 0, 0, time.UTC)
+)
+
+var errNoNodeName = errors.New("node config has no name")
+
+// NewStakingIdentity returns the staking cert and key of node [index]
+// for [seed], PEM encoded, as staking.NewCertAndKeyBytes does.
+// The same seed and index always give the same cert and key,
+// and so the same node ID.
+func NewStakingIdentity(seed string, index uint32) ([]byte, []byte, error) {
+	r := newIdentityReader(seed, index)
+	key, err := generateRSAKey(r, stakingKeySize)
+	if err != nil {
+		return nil, nil, fmt.Errorf("couldn't generate rsa key: %w", err)
+	}
+
+	certTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(0),
+		NotBefore:             stakingCertNotBefore,
+		NotAfter:              stakingCertNotAfter,
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageDataEncipherment,
+		BasicConstraintsValid: true,
+	}
+	// PKCS #1 v1.5 signatures don't use randomness
+	certBytes, err := x509.CreateCertificate(r, certTemplate, certTemplate, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("couldn't create certificate: %w", err)
+	}
+	var certBuff bytes.Buffer
+	if err := pem.Encode(&certBuff, &pem.Block{Type: "CERTIFICATE", Bytes: certBytes}); err != nil {
+		return nil, nil, fmt.Errorf("couldn't write cert file: %w", err)
+	}
+
+	privBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("couldn't marshal private key: %w", err)
+	}
+	var keyBuff bytes.Buffer
+	if err := pem.Encode(&keyBuff, &pem.Block{Type: "PRIVATE KEY", Bytes: privBytes}); err != nil {
+		return nil, nil, fmt.Errorf("couldn't write private key: %w", err)
+	}
+	return certBuff.Bytes(), keyBuff.Bytes(), nil
+}
+
+// WriteStakingIdentities writes the staking key and cert of each node of
+// [netConfig] in a directory named after the node under [dir], and the
+// node ID of each node, by node name, in file NodeIDsFileName of [dir].
+func WriteStakingIdentities(dir string, netConfig network.Config) error {
+	nodeIDs := make(map[string]string, len(netConfig.NodeConfigs))
+	for i, nodeConfig := range netConfig.NodeConfigs {
+		if nodeConfig.Name == "" {
+			return fmt.Errorf("node %d: %w", i, errNoNodeName)
+		}
+		nodeID, err := utils.ToNodeID([]byte(nodeConfig.StakingKey), []byte(nodeConfig.StakingCert))
+		if err != nil {
+			return fmt.Errorf("couldn't get node ID of node %q: %w", nodeConfig.Name, err)
+		}
+		nodeIDs[nodeConfig.Name] = nodeID.PrefixedString(constants.NodeIDPrefix)
+
+		nodeDir := filepath.Join(dir, nodeConfig.Name)
+		if err := os.MkdirAll(nodeDir, 0o755); err != nil {
+			return err
+		}
+		if err := createFileAndWrite(filepath.Join(nodeDir, stakingKeyFileName), []byte(nodeConfig.StakingKey)); err != nil {
+			return err
+		}
+		if err := createFileAndWrite(filepath.Join(nodeDir, stakingCertFileName), []byte(nodeConfig.StakingCert)); err != nil {
+			return err
+		}
+	}
+	nodeIDsBytes, err := json.MarshalIndent(nodeIDs, "", "  ")
+	if err != nil {
+		return err
+	}
+	return createFileAndWrite(filepath.Join(dir, NodeIDsFileName), nodeIDsBytes)
+}
+
+// identityReader is an endless stream of bytes derived from a seed and an
+// index: the SHA-256 hashes of the seed, the index and a block counter
+type identityReader struct {
+	prefix  []byte
+	counter uint64
+	buf     []byte
+}
+
+func newIdentityReader(seed string, index uint32) *identityReader {
+	prefix := make([]byte, 0, len(seed)+4)
+	prefix = append(prefix, seed...)
+	prefix = append(prefix, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(prefix[len(seed):], index)
+	return &identityReader{prefix: prefix}
+}
+
+func (r *identityReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(r.buf) == 0 {
+			block := make([]byte, len(r.prefix)+8)
+			copy(block, r.prefix)
+			binary.BigEndian.PutUint64(block[len(r.prefix):], r.counter)
+			r.counter++
+			hash := sha256.Sum256(block)
+			r.buf = hash[:]
+		}
+		copied := copy(p[n:], r.buf)
+		r.buf = r.buf[copied:]
+		n += copied
+	}
+	return n, nil
+}
+
+// generateRSAKey returns an RSA key of [bits] bits generated from the
+// bytes of [r]. Unlike rsa.GenerateKey, the key only depends on them.
+func generateRSAKey(r io.Reader, bits int) (*rsa.PrivateKey, error) {
+	e := big.NewInt(stakingKeyExponent)
+	one := big.NewInt(1)
+	for {
+		p, err := generatePrime(r, bits/2, e)
+		if err != nil {
+			return nil, err
+		}
+		q, err := generatePrime(r, bits-bits/2, e)
+		if err != nil {
+			return nil, err
+		}
+		if p.Cmp(q) == 0 {
+			continue
+		}
+		n := new(big.Int).Mul(p, q)
+		if n.BitLen() != bits {
+			continue
+		}
+		pMinus1 := new(big.Int).Sub(p, one)
+		qMinus1 := new(big.Int).Sub(q, one)
+		totient := new(big.Int).Mul(pMinus1, qMinus1)
+		d := new(big.Int).ModInverse(e, totient)
+		if d == nil {
+			continue
+		}
+		key := &rsa.PrivateKey{
+			PublicKey: rsa.PublicKey{N: n, E: stakingKeyExponent},
+			D:         d,
+			Primes:    []*big.Int{p, q},
+		}
+		key.Precompute()
+		if err := key.Validate(); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+}
+
+// generatePrime returns a prime of [bits] bits, with its two top bits set,
+// such that p-1 is coprime with [e], searching up from bytes read from [r]
+func generatePrime(r io.Reader, bits int, e *big.Int) (*big.Int, error) {
+	b := make([]byte, (bits+7)/8)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	// Clear the bits above [bits], and set the two top ones,
+	// so the product of two such primes has twice as many bits
+	if excess := uint(len(b)*8 - bits); excess > 0 {
+		b[0] &= byte(0xff >> excess)
+	}
+	p := new(big.Int).SetBytes(b)
+	p.SetBit(p, bits-1, 1)
+	p.SetBit(p, bits-2, 1)
+	p.SetBit(p, 0, 1)
+
+	two := big.NewInt(2)
+	one := big.NewInt(1)
+	pMinus1 := new(big.Int)
+	gcd := new(big.Int)
+	for p.BitLen() == bits {
+		if p.ProbablyPrime(20) {
+			pMinus1.Sub(p, one)
+			if gcd.GCD(nil, nil, pMinus1, e).Cmp(one) == 0 {
+				return p, nil
+			}
+		}
+		p.Add(p, two)
+	}
+	return nil, fmt.Errorf("no prime of %d bits found", bits)
+}
@@ -0,0 +1,68 @@
+package local
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStakingIdentity(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	// The same seed and index give the same identity
+	cert, key, err := NewStakingIdentity("seed", DefaultNumNodes)
+	assert.NoError(err)
+	_, err = staking.LoadTLSCertFromBytes(key, cert)
+	assert.NoError(err)
+	cert2, key2, err := NewStakingIdentity("seed", DefaultNumNodes)
+	assert.NoError(err)
+	assert.Equal(cert, cert2)
+	assert.Equal(key, key2)
+
+	// Other indices and seeds give other identities
+	cert3, _, err := NewStakingIdentity("seed", DefaultNumNodes+1)
+	assert.NoError(err)
+	assert.NotEqual(cert, cert3)
+	cert4, _, err := NewStakingIdentity("other seed", DefaultNumNodes)
+	assert.NoError(err)
+	assert.NotEqual(cert, cert4)
+
+	// Nodes past the default ones get the identities of the seed
+	netConfig, err := NewDefaultConfigNNodesFromSeed("pepito", DefaultNumNodes+1, "seed")
+	assert.NoError(err)
+	assert.Len(netConfig.NodeConfigs, DefaultNumNodes+1)
+	assert.Equal(defaultNetworkConfig.NodeConfigs[0].StakingCert, netConfig.NodeConfigs[0].StakingCert)
+	assert.Equal(string(cert), netConfig.NodeConfigs[DefaultNumNodes].StakingCert)
+	assert.Equal(string(key), netConfig.NodeConfigs[DefaultNumNodes].StakingKey)
+
+	// Identities are written by node name
+	dir := t.TempDir()
+	assert.Error(WriteStakingIdentities(dir, netConfig))
+	for i := range netConfig.NodeConfigs {
+		netConfig.NodeConfigs[i].Name = fmt.Sprintf("node%d", i)
+	}
+	assert.NoError(WriteStakingIdentities(dir, netConfig))
+	lastNode := netConfig.NodeConfigs[DefaultNumNodes]
+	writtenCert, err := os.ReadFile(filepath.Join(dir, lastNode.Name, stakingCertFileName))
+	assert.NoError(err)
+	assert.Equal(cert, writtenCert)
+	writtenKey, err := os.ReadFile(filepath.Join(dir, lastNode.Name, stakingKeyFileName))
+	assert.NoError(err)
+	assert.Equal(key, writtenKey)
+	nodeIDsBytes, err := os.ReadFile(filepath.Join(dir, NodeIDsFileName))
+	assert.NoError(err)
+	var nodeIDs map[string]string
+	assert.NoError(json.Unmarshal(nodeIDsBytes, &nodeIDs))
+	assert.Len(nodeIDs, DefaultNumNodes+1)
+	nodeID, err := utils.ToNodeID(key, cert)
+	assert.NoError(err)
+	assert.Equal(nodeID.PrefixedString(constants.NodeIDPrefix), nodeIDs[lastNode.Name])
+}
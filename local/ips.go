@@ -0,0 +1,47 @@
+package local
+
+import (
+	"fmt"
+	"net"
+)
+
+// Number of loopback IPs given to the nodes of networks with distinct node
+// IPs: 127.0.1.1 to 127.0.255.254, skipping the network and broadcast-like
+// addresses .0 and .255 of each /24
+const maxNodeIPs = 255 * 254
+
+// getNodeIP returns the loopback IP of node [nodeName], which is the same
+// each time a node of that name is added to the network
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) getNodeIP(nodeName string) (net.IP, error) {
+	if ip, ok := ln.nodeIPs[nodeName]; ok {
+		return ip, nil
+	}
+	if len(ln.nodeIPs) >= maxNodeIPs {
+		return nil, fmt.Errorf("no loopback IP left for node %q", nodeName)
+	}
+	if ln.nodeIPs == nil {
+		ln.nodeIPs = map[string]net.IP{}
+	}
+	// The IPs of adopted nodes may leave gaps
+	used := make(map[string]struct{}, len(ln.nodeIPs))
+	for _, ip := range ln.nodeIPs {
+		used[ip.String()] = struct{}{}
+	}
+	index := len(ln.nodeIPs)
+	for i := 0; i < maxNodeIPs; i++ {
+		if _, ok := used[nodeIP(i).String()]; !ok {
+			index = i
+			break
+		}
+	}
+	ip := nodeIP(index)
+	ln.nodeIPs[nodeName] = ip
+	return ip, nil
+}
+
+// nodeIP returns loopback IP [index] of the nodes:
+// 127.0.1.1, ..., 127.0.1.254, 127.0.2.1, ...
+func nodeIP(index int) net.IP {
+	return net.IPv4(127, 0, byte(1+index/254), byte(1+index%254))
+}
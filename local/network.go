@@ -4,6 +4,7 @@ import (
 	"context"
 	"embed"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
@@ -11,7 +12,9 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/ava-labs/avalanche-network-runner/api"
@@ -19,7 +22,7 @@ import (
 	"github.com/ava-labs/avalanche-network-runner/network/node"
 	"github.com/ava-labs/avalanche-network-runner/utils"
 	"github.com/ava-labs/avalanchego/config"
-	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/ids"
 	avago_utils "github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/beacon"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -35,10 +38,17 @@ const (
 	stakingCertFileName   = "staking.crt"
 	genesisFileName       = "genesis.json"
 	stopTimeout           = 30 * time.Second
+	maxParallelNodeStarts = 8
 	healthCheckFreq       = 3 * time.Second
 	DefaultNumNodes       = 5
+	snapshotsSubDir       = "snapshots"
+	logsSubDir            = "logs"
 )
 
+// TempRootDirPattern is the pattern of the names of the root dirs
+// created in the temporary dir for networks not given one
+const TempRootDirPattern = "avalanche-network-runner-*"
+
 // interface compliance
 var (
 	_ network.Network    = (*localNetwork)(nil)
@@ -79,6 +89,33 @@ type localNetwork struct {
 	rootDir string
 	// Flags to apply to all nodes if not present
 	flags map[string]interface{}
+	// snapshotsDir is the directory under which network
+	// snapshots are saved and loaded from
+	snapshotsDir string
+	// Sends the events of this network to its subscribers
+	events *network.EventBus
+	// Range the ports of the nodes are allocated from.
+	// See network.Config.PortRange.
+	portRange network.PortRange
+	// True if nodes reach each other through proxies.
+	// See network.Config.P2PProxy.
+	p2pProxy bool
+	// True if each node gets its own loopback IP.
+	// See network.Config.DistinctNodeIPs.
+	distinctNodeIPs bool
+	// Node name --> loopback IP given to the nodes of that name
+	nodeIPs map[string]net.IP
+	// Time a node is given to exit once sent a SIGTERM.
+	// See network.Config.StopGracePeriod.
+	stopGracePeriod time.Duration
+	// Node name --> index of its group in the current partition.
+	// Nil if the network isn't partitioned.
+	partition map[string]int
+	// Node name --> faults injected in its P2P traffic
+	nodeFaults map[string]network.LinkFaults
+	// Link --> faults injected in its P2P traffic,
+	// instead of the faults of its nodes
+	linkFaults map[linkKey]network.LinkFaults
 }
 
 var (
@@ -156,40 +193,129 @@ type nodeProcessCreator struct {
 
 // NewNodeProcess creates a new process of the passed binary
 // If the config has redirection set to `true` for either StdErr or StdOut,
-// the output will be redirected and colored
+// the output will be redirected and colored.
+// If the config has an output logs dir, the output is written to rotated
+// files in it, which the process writes to itself, so that it keeps running
+// if the network runner exits. The redirected output is read from them.
 func (npc *nodeProcessCreator) NewNodeProcess(config node.Config, args ...string) (NodeProcess, error) {
 	// Start the AvalancheGo node and pass it the flags defined above
 	cmd := exec.Command(config.BinaryPath, args...)
+	// Run the node in its own process group, so that the processes
+	// it starts get its signals too, and aren't left behind
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	output, err := npc.newNodeOutput(config)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stdout = output.stdout
+	cmd.Stderr = output.stderr
+	return &nodeProcessImpl{cmd: cmd, closeOnWait: output.closers}, nil
+}
+
+// nodeOutput is where the stdout and stderr of a node process go
+type nodeOutput struct {
+	// Given to the process. Nil if the output is discarded.
+	stdout io.Writer
+	stderr io.Writer
+	// Closed once the process exits, in order
+	closers []io.Closer
+}
+
+// newNodeOutput returns where the output of the node process of [config]
+// goes: its output log files, if the config has an output logs dir, or else
+// the pipes it's redirected through, if any.
+func (npc *nodeProcessCreator) newNodeOutput(config node.Config) (*nodeOutput, error) {
+	output := &nodeOutput{}
 	// assign a new color to this process (might not be used if the config isn't set for it)
 	color := npc.colorPicker.NextColor()
-	// Optionally redirect stdout and stderr
+	// Optionally redirect stdout and stderr.
+	// The output goes through pipes closed once the process exits, after
+	// the output logs, instead of the ones given by cmd.StdoutPipe/StderrPipe,
+	// so that the process can be waited on while its output is still being read.
+	var stdoutRedirect, stderrRedirect io.WriteCloser
+	var redirects []io.Closer
 	if config.RedirectStdout {
-		stdout, err := cmd.StdoutPipe()
-		if err != nil {
-			return nil, fmt.Errorf("Could not create stdout pipe: %s", err)
-		}
+		stdoutReader, stdoutWriter := io.Pipe()
+		stdoutRedirect = stdoutWriter
+		redirects = append(redirects, stdoutWriter)
 		// redirect stdout and assign a color to the text
-		utils.ColorAndPrepend(stdout, npc.stdout, config.Name, color)
+		utils.ColorAndPrepend(stdoutReader, npc.stdout, config.Name, color)
 	}
 	if config.RedirectStderr {
-		stderr, err := cmd.StderrPipe()
-		if err != nil {
-			return nil, fmt.Errorf("Could not create stderr pipe: %s", err)
-		}
+		stderrReader, stderrWriter := io.Pipe()
+		stderrRedirect = stderrWriter
+		redirects = append(redirects, stderrWriter)
 		// redirect stderr and assign a color to the text
-		utils.ColorAndPrepend(stderr, npc.stderr, config.Name, color)
+		utils.ColorAndPrepend(stderrReader, npc.stderr, config.Name, color)
+	}
+	if config.OutputLogs.Dir == "" {
+		if stdoutRedirect != nil {
+			output.stdout = stdoutRedirect
+		}
+		if stderrRedirect != nil {
+			output.stderr = stderrRedirect
+		}
+		output.closers = redirects
+		return output, nil
+	}
+
+	stdoutLog, err := newNodeOutputLog(config.OutputLogs, node.StdoutLogFileName, stdoutRedirect)
+	if err != nil {
+		output.closers = redirects
+		_ = output.Close()
+		return nil, err
+	}
+	stderrLog, err := newNodeOutputLog(config.OutputLogs, node.StderrLogFileName, stderrRedirect)
+	if err != nil {
+		output.closers = append([]io.Closer{stdoutLog}, redirects...)
+		_ = output.Close()
+		return nil, err
+	}
+	output.stdout = stdoutLog.file
+	output.stderr = stderrLog.file
+	output.closers = append([]io.Closer{stdoutLog, stderrLog}, redirects...)
+	return output, nil
+}
+
+// Close closes the output, once the process exited
+func (o *nodeOutput) Close() error {
+	for _, closer := range o.closers {
+		_ = closer.Close()
+	}
+	return nil
+}
+
+// newNodeOutputLog returns the output log [fileName] of [outputLogs],
+// whose output is copied to [redirect], if not nil
+func newNodeOutputLog(outputLogs node.OutputLogs, fileName string, redirect io.Writer) (*outputLog, error) {
+	maxSize := outputLogs.MaxSize
+	if maxSize == 0 {
+		maxSize = node.DefaultOutputLogMaxSize
+	}
+	maxFiles := outputLogs.MaxFiles
+	if maxFiles == 0 {
+		maxFiles = node.DefaultOutputLogMaxFiles
 	}
-	return &nodeProcessImpl{cmd: cmd}, nil
+	if err := os.MkdirAll(outputLogs.Dir, 0o755); err != nil {
+		return nil, err
+	}
+	return newOutputLog(filepath.Join(outputLogs.Dir, fileName), maxSize, maxFiles, redirect)
 }
 
 // NewNetwork returns a new network from the given config that uses the given log.
-// Files (e.g. logs, databases) default to being written at directory [dir].
-// If there isn't a directory at [dir] one will be created.
-// If len([dir]) == 0, files will be written underneath a new temporary directory.
+// Files (e.g. logs, databases) default to being written at directory [rootDir].
+// If there isn't a directory at [rootDir] one will be created.
+// If len([rootDir]) == 0, files will be written underneath a new temporary directory.
+// Snapshots are saved to and loaded from [snapshotsDir].
+// If len([snapshotsDir]) == 0, snapshots are kept under [rootDir]/snapshots.
+// The events of the network are published on [events], so that they can be
+// watched from the creation of the first node. If nil, a new bus is used.
 func NewNetwork(
 	log logging.Logger,
 	networkConfig network.Config,
-	dir string,
+	rootDir string,
+	snapshotsDir string,
+	events *network.EventBus,
 ) (network.Network, error) {
 	return newNetwork(
 		log,
@@ -200,7 +326,31 @@ func NewNetwork(
 			stdout:      os.Stdout,
 			stderr:      os.Stderr,
 		},
-		dir,
+		rootDir,
+		snapshotsDir,
+		events,
+	)
+}
+
+// NewNetworkWithNodeProcessCreator is like NewNetwork, but the avalanchego
+// processes are launched by [nodeProcessCreator], which may run them on
+// other hosts by returning RemoteNodeProcesses.
+func NewNetworkWithNodeProcessCreator(
+	log logging.Logger,
+	networkConfig network.Config,
+	nodeProcessCreator NodeProcessCreator,
+	rootDir string,
+	snapshotsDir string,
+	events *network.EventBus,
+) (network.Network, error) {
+	return newNetwork(
+		log,
+		networkConfig,
+		api.NewAPIClient,
+		nodeProcessCreator,
+		rootDir,
+		snapshotsDir,
+		events,
 	)
 }
 
@@ -212,63 +362,129 @@ func newNetwork(
 	networkConfig network.Config,
 	newAPIClientF api.NewAPIClientF,
 	nodeProcessCreator NodeProcessCreator,
-	dir string,
+	rootDir string,
+	snapshotsDir string,
+	events *network.EventBus,
 ) (network.Network, error) {
-	if err := networkConfig.Validate(); err != nil {
-		return nil, fmt.Errorf("config failed validation: %w", err)
-	}
-	log.Info("creating network with %d nodes", len(networkConfig.NodeConfigs))
-
-	networkID, err := utils.NetworkIDFromGenesis([]byte(networkConfig.Genesis))
+	net, err := newLocalNetwork(log, newAPIClientF, nodeProcessCreator, rootDir, snapshotsDir, events)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't get network ID from genesis: %w", err)
+		return nil, err
+	}
+	if err := net.loadConfig(context.Background(), networkConfig); err != nil {
+		return nil, err
 	}
+	return net, nil
+}
 
-	// Create the network
-	net := &localNetwork{
-		networkID:          networkID,
-		genesis:            []byte(networkConfig.Genesis),
+// newLocalNetwork returns a new network with no nodes in it.
+// See NewNetwork for the meaning of [rootDir], [snapshotsDir] and [events].
+func newLocalNetwork(
+	log logging.Logger,
+	newAPIClientF api.NewAPIClientF,
+	nodeProcessCreator NodeProcessCreator,
+	rootDir string,
+	snapshotsDir string,
+	events *network.EventBus,
+) (*localNetwork, error) {
+	var err error
+	if rootDir == "" {
+		rootDir, err = os.MkdirTemp("", TempRootDirPattern)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if snapshotsDir == "" {
+		snapshotsDir = filepath.Join(rootDir, snapshotsSubDir)
+	}
+	if events == nil {
+		events = &network.EventBus{}
+	}
+	return &localNetwork{
 		nodes:              map[string]*localNode{},
 		closedOnStopCh:     make(chan struct{}),
 		log:                log,
 		bootstraps:         beacon.NewSet(),
 		newAPIClientF:      newAPIClientF,
 		nodeProcessCreator: nodeProcessCreator,
-		flags:              networkConfig.Flags,
+		rootDir:            rootDir,
+		snapshotsDir:       snapshotsDir,
+		events:             events,
+		stopGracePeriod:    defaultStopGracePeriod,
+	}, nil
+}
+
+// loadConfig validates [networkConfig] and starts the nodes it defines.
+// If a node fails to start, all the nodes are stopped.
+// Assumes [ln.lock] is held, or that [ln] isn't accessible to other goroutines yet.
+func (ln *localNetwork) loadConfig(ctx context.Context, networkConfig network.Config) error {
+	if err := ln.setConfig(networkConfig); err != nil {
+		return err
 	}
+	ln.log.Info("creating network with %d nodes", len(networkConfig.NodeConfigs))
+	return ln.startNodes(ctx, networkConfig.NodeConfigs)
+}
 
-	// Sort node configs so beacons start first
-	var nodeConfigs []node.Config
-	for _, nodeConfig := range networkConfig.NodeConfigs {
-		if nodeConfig.IsBeacon {
-			nodeConfigs = append(nodeConfigs, nodeConfig)
+// setConfig validates [networkConfig] and applies its network-wide
+// settings, without starting its nodes.
+// Assumes [ln.lock] is held, or that [ln] isn't accessible to other goroutines yet.
+func (ln *localNetwork) setConfig(networkConfig network.Config) error {
+	if err := networkConfig.Validate(); err != nil {
+		return fmt.Errorf("config failed validation: %w", err)
+	}
+
+	networkID, err := utils.NetworkIDFromGenesis([]byte(networkConfig.Genesis))
+	if err != nil {
+		return fmt.Errorf("couldn't get network ID from genesis: %w", err)
+	}
+	ln.networkID = networkID
+	ln.genesis = []byte(networkConfig.Genesis)
+	if len(networkConfig.CChainAllocations) > 0 {
+		ln.genesis, err = network.AddCChainAllocations(ln.genesis, networkConfig.CChainAllocations)
+		if err != nil {
+			return fmt.Errorf("couldn't add C-Chain allocations to genesis: %w", err)
 		}
 	}
-	for _, nodeConfig := range networkConfig.NodeConfigs {
+	ln.flags = networkConfig.Flags
+	ln.portRange = networkConfig.PortRange
+	ln.p2pProxy = networkConfig.P2PProxy
+	ln.distinctNodeIPs = networkConfig.DistinctNodeIPs
+	ln.stopGracePeriod = networkConfig.StopGracePeriod
+	if ln.stopGracePeriod == 0 {
+		ln.stopGracePeriod = defaultStopGracePeriod
+	}
+	return nil
+}
+
+// startNodes starts the nodes of [configs].
+// If a node fails to start, all the nodes of the network are stopped.
+// Assumes [ln.lock] is held, or that [ln] isn't accessible to other goroutines yet.
+func (ln *localNetwork) startNodes(ctx context.Context, configs []node.Config) error {
+	// Beacons start first, one by one, as each bootstraps from the ones before it
+	var nodeConfigs []node.Config
+	for _, nodeConfig := range configs {
 		if !nodeConfig.IsBeacon {
 			nodeConfigs = append(nodeConfigs, nodeConfig)
+			continue
 		}
-	}
-
-	if dir == "" {
-		net.rootDir, err = os.MkdirTemp("", "avalanche-network-runner-*")
-		if err != nil {
-			return nil, err
+		if _, err := ln.addNode(nodeConfig); err != nil {
+			ln.stopOnLoadErr(ctx)
+			return fmt.Errorf("error adding node %s: %s", nodeConfig.Name, err)
 		}
-	} else {
-		net.rootDir = dir
 	}
+	if err := ln.addNodes(nodeConfigs); err != nil {
+		ln.stopOnLoadErr(ctx)
+		return err
+	}
+	return nil
+}
 
-	for _, nodeConfig := range nodeConfigs {
-		if _, err := net.addNode(nodeConfig); err != nil {
-			if err := net.stop(context.Background()); err != nil {
-				// Clean up nodes already created
-				log.Debug("error stopping network: %s", err)
-			}
-			return nil, fmt.Errorf("error adding node %s: %s", nodeConfig.Name, err)
-		}
+// stopOnLoadErr stops the nodes already added when a network
+// can't be loaded
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) stopOnLoadErr(ctx context.Context) {
+	if err := ln.stop(ctx); err != nil {
+		ln.log.Debug("error stopping network: %s", err)
 	}
-	return net, nil
 }
 
 // NewDefaultNetwork returns a new network using a pre-defined
@@ -308,7 +524,7 @@ func newDefaultNetwork(
 	nodeProcessCreator NodeProcessCreator,
 ) (network.Network, error) {
 	config := NewDefaultConfig(binaryPath)
-	return newNetwork(log, config, newAPIClientF, nodeProcessCreator, "")
+	return newNetwork(log, config, newAPIClientF, nodeProcessCreator, "", "", nil)
 }
 
 // NewDefaultConfig creates a new default network config
@@ -323,34 +539,63 @@ func NewDefaultConfig(binaryPath string) network.Config {
 	return config
 }
 
-// NewDefaultConfigNNodes creates a new default network config, with an arbitrary number of nodes
+// NewDefaultConfigNNodes creates a new default network config, with an arbitrary number of nodes.
+// The staking identities of the nodes past the default ones are derived from DefaultIdentitySeed.
 func NewDefaultConfigNNodes(binaryPath string, numNodes uint32) (network.Config, error) {
+	return NewDefaultConfigNNodesFromSeed(binaryPath, numNodes, DefaultIdentitySeed)
+}
+
+// NewDefaultConfigNNodesFromSeed creates a new default network config, with an arbitrary
+// number of nodes. The default nodes keep their identities, as they are the validators
+// of the default genesis, and node i past them gets staking identity i of [seed].
+func NewDefaultConfigNNodesFromSeed(binaryPath string, numNodes uint32, seed string) (network.Config, error) {
+	return NewDefaultConfigNNodesAndBeaconsFromSeed(binaryPath, numNodes, numNodes, seed)
+}
+
+// NewDefaultConfigNNodesAndBeacons is like NewDefaultConfigNNodes,
+// but only the first [numBeacons] nodes are beacons.
+func NewDefaultConfigNNodesAndBeacons(binaryPath string, numNodes uint32, numBeacons uint32) (network.Config, error) {
+	return NewDefaultConfigNNodesAndBeaconsFromSeed(binaryPath, numNodes, numBeacons, DefaultIdentitySeed)
+}
+
+// NewDefaultConfigNNodesAndBeaconsFromSeed is like NewDefaultConfigNNodesFromSeed,
+// but only the first [numBeacons] nodes are beacons.
+func NewDefaultConfigNNodesAndBeaconsFromSeed(
+	binaryPath string,
+	numNodes uint32,
+	numBeacons uint32,
+	seed string,
+) (network.Config, error) {
 	netConfig := NewDefaultConfig(binaryPath)
+	if numBeacons > numNodes || (numBeacons == 0 && numNodes > 0) {
+		return netConfig, fmt.Errorf("number of beacons must be between 1 and the number of nodes (%d), got %d", numNodes, numBeacons)
+	}
 	if int(numNodes) > len(netConfig.NodeConfigs) {
 		toAdd := int(numNodes) - len(netConfig.NodeConfigs)
 		refNodeConfig := netConfig.NodeConfigs[0]
+		// Ports are removed so the network allocates them
+		configFile, err := removeConfigFileKeys(refNodeConfig.ConfigFile, config.HTTPPortKey, config.StakingPortKey)
+		if err != nil {
+			return netConfig, err
+		}
 		for i := 0; i < toAdd; i++ {
 			nodeConfig := refNodeConfig
-			stakingCert, stakingKey, err := staking.NewCertAndKeyBytes()
+			stakingCert, stakingKey, err := NewStakingIdentity(seed, uint32(len(netConfig.NodeConfigs)))
 			if err != nil {
 				return netConfig, fmt.Errorf("couldn't generate staking Cert/Key: %w", err)
 			}
 			nodeConfig.StakingKey = string(stakingKey)
 			nodeConfig.StakingCert = string(stakingCert)
-			// replace api port in refNodeConfig.ConfigFile
-			apiPort, err := getFreePort()
-			if err != nil {
-				return netConfig, fmt.Errorf("couldn't get free API port: %w", err)
-			}
-			nodeConfig.Flags = map[string]interface{}{
-				config.HTTPPortKey: int(apiPort),
-			}
+			nodeConfig.ConfigFile = configFile
 			netConfig.NodeConfigs = append(netConfig.NodeConfigs, nodeConfig)
 		}
 	}
 	if int(numNodes) < len(netConfig.NodeConfigs) {
 		netConfig.NodeConfigs = netConfig.NodeConfigs[:numNodes]
 	}
+	for i := range netConfig.NodeConfigs {
+		netConfig.NodeConfigs[i].IsBeacon = i < int(numBeacons)
+	}
 	return netConfig, nil
 }
 
@@ -364,22 +609,177 @@ func (ln *localNetwork) AddNode(nodeConfig node.Config) (node.Node, error) {
 
 // Assumes [ln.lock] is held.
 func (ln *localNetwork) addNode(nodeConfig node.Config) (node.Node, error) {
+	pending, err := ln.prepareNode(nodeConfig)
+	if err != nil {
+		return nil, err
+	}
+	if err := ln.startNodeProcess(pending); err != nil {
+		ln.releaseNode(pending)
+		return nil, err
+	}
+	return ln.registerNode(pending)
+}
+
+// addNodes adds the nodes of [nodeConfigs], in batches whose nodes don't
+// bootstrap from each other, each added once the nodes its nodes bootstrap
+// from were. See [addNodeBatch].
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) addNodes(nodeConfigs []node.Config) error {
+	for len(nodeConfigs) > 0 {
+		batch, rest, err := nextNodeBatch(nodeConfigs)
+		if err != nil {
+			return err
+		}
+		if err := ln.addNodeBatch(batch); err != nil {
+			return err
+		}
+		nodeConfigs = rest
+	}
+	return nil
+}
+
+// nextNodeBatch splits [nodeConfigs] into the nodes that don't bootstrap
+// from any of them, and the others.
+// Returns an error if every node bootstraps from another one.
+func nextNodeBatch(nodeConfigs []node.Config) ([]node.Config, []node.Config, error) {
+	pending := make(map[string]bool, len(nodeConfigs))
+	for _, nodeConfig := range nodeConfigs {
+		if nodeConfig.Name != "" {
+			pending[nodeConfig.Name] = true
+		}
+	}
+	var batch, rest []node.Config
+	for _, nodeConfig := range nodeConfigs {
+		ready := true
+		for _, bootstrapNode := range nodeConfig.BootstrapNodes {
+			if pending[bootstrapNode] {
+				ready = false
+				break
+			}
+		}
+		if ready {
+			batch = append(batch, nodeConfig)
+		} else {
+			rest = append(rest, nodeConfig)
+		}
+	}
+	if len(batch) == 0 {
+		return nil, nil, fmt.Errorf("nodes %s bootstrap from each other", nodeNamesOf(rest))
+	}
+	return batch, rest, nil
+}
+
+func nodeNamesOf(nodeConfigs []node.Config) []string {
+	names := make([]string, len(nodeConfigs))
+	for i, nodeConfig := range nodeConfigs {
+		names[i] = nodeConfig.Name
+	}
+	return names
+}
+
+// addNodeBatch adds the nodes of [nodeConfigs], starting up to
+// [maxParallelNodeStarts] of their processes at once.
+// The nodes whose processes were started are added even if an error
+// is returned for others.
+// With a P2P proxy, the nodes are added one by one, as each is
+// linked to the proxies of the nodes added before it.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) addNodeBatch(nodeConfigs []node.Config) error {
+	if ln.p2pProxy {
+		for _, nodeConfig := range nodeConfigs {
+			if _, err := ln.addNode(nodeConfig); err != nil {
+				return fmt.Errorf("error adding node %s: %s", nodeConfig.Name, err)
+			}
+		}
+		return nil
+	}
+
+	errs := wrappers.Errs{}
+	pendingNodes := make([]*pendingNode, 0, len(nodeConfigs))
+	for _, nodeConfig := range nodeConfigs {
+		pending, err := ln.prepareNode(nodeConfig)
+		if err != nil {
+			errs.Add(fmt.Errorf("error adding node %s: %s", nodeConfig.Name, err))
+			break
+		}
+		pendingNodes = append(pendingNodes, pending)
+	}
+
+	// The processes are started without changing the network,
+	// so the nodes are then released or registered in order
+	startErrs := make([]error, len(pendingNodes))
+	sem := make(chan struct{}, maxParallelNodeStarts)
+	errGr := errgroup.Group{}
+	for i, pending := range pendingNodes {
+		i, pending := i, pending
+		errGr.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			startErrs[i] = ln.startNodeProcess(pending)
+			return nil
+		})
+	}
+	_ = errGr.Wait()
+	for i, pending := range pendingNodes {
+		if startErrs[i] != nil {
+			ln.releaseNode(pending)
+			errs.Add(fmt.Errorf("error adding node %s: %s", pending.config.Name, startErrs[i]))
+			continue
+		}
+		if _, err := ln.registerNode(pending); err != nil {
+			errs.Add(fmt.Errorf("error adding node %s: %s", pending.config.Name, err))
+		}
+	}
+	return errs.Err
+}
+
+// pendingNode is a node being added, whose process
+// may be started, but which isn't in the network yet
+type pendingNode struct {
+	config   node.Config
+	nodeID   ids.ShortID
+	nodeData buildFlagsReturn
+	// Loopback IP of the node, if the network gives distinct ones
+	nodeIP net.IP
+	// Set once the process is started
+	process NodeProcess
+	host    string
+	ip      net.IP
+}
+
+// prepareNode names [nodeConfig], makes the dirs of its node,
+// and reserves its ports and proxy links
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) prepareNode(nodeConfig node.Config) (*pendingNode, error) {
 	if ln.isStopped() {
 		return nil, network.ErrStopped
 	}
 
-	if nodeConfig.Flags == nil {
-		nodeConfig.Flags = make(map[string]interface{})
+	// Flags are added to the node's flags, so the caller's map is copied
+	nodeFlags := make(map[string]interface{}, len(nodeConfig.Flags))
+	for k, v := range nodeConfig.Flags {
+		nodeFlags[k] = v
 	}
+	nodeConfig.Flags = nodeFlags
 
 	if err := ln.setNodeName(&nodeConfig); err != nil {
 		return nil, err
 	}
 
+	if err := nodeConfig.RestartPolicy.Validate(); err != nil {
+		return nil, err
+	}
+	if err := nodeConfig.OutputLogs.Validate(); err != nil {
+		return nil, err
+	}
+
 	nodeDir, err := makeNodeDir(ln.log, ln.rootDir, nodeConfig.Name)
 	if err != nil {
 		return nil, err
 	}
+	if nodeConfig.OutputLogs.Dir == "" {
+		nodeConfig.OutputLogs.Dir = nodeDir
+	}
 
 	// If config file is given, don't overwrite API port, P2P port, DB path, logs path
 	var configFile map[string]interface{}
@@ -389,46 +789,202 @@ func (ln *localNetwork) addNode(nodeConfig node.Config) (node.Node, error) {
 		}
 	}
 
-	flags, apiPort, p2pPort, err := ln.buildFlags(configFile, nodeDir, &nodeConfig)
+	var nodeIP net.IP
+	if ln.distinctNodeIPs {
+		nodeIP, err = ln.getNodeIP(nodeConfig.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bootstraps, err := ln.getBootstraps(nodeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeData, err := ln.buildFlags(configFile, nodeDir, &nodeConfig, bootstraps, nodeIP)
 	if err != nil {
+		ln.removeProxyLinks(nodeConfig.Name)
 		return nil, err
 	}
+	pending := &pendingNode{
+		config:   nodeConfig,
+		nodeData: nodeData,
+		nodeIP:   nodeIP,
+	}
 
 	// Parse this node's ID
-	nodeID, err := utils.ToNodeID([]byte(nodeConfig.StakingKey), []byte(nodeConfig.StakingCert))
+	pending.nodeID, err = utils.ToNodeID([]byte(nodeConfig.StakingKey), []byte(nodeConfig.StakingCert))
 	if err != nil {
+		ln.releaseNode(pending)
 		return nil, fmt.Errorf("couldn't get node ID: %w", err)
 	}
+	return pending, nil
+}
+
+// getBootstraps returns the beacons node [nodeConfig] bootstraps from: its
+// bootstrap nodes if given, or else the beacons of the network. With a P2P
+// proxy, the links of the node to the proxies of the other nodes are added,
+// and it bootstraps from all the nodes if its bootstrap nodes aren't given.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) getBootstraps(nodeConfig node.Config) (beacon.Set, error) {
+	for _, bootstrapNode := range nodeConfig.BootstrapNodes {
+		if _, ok := ln.nodes[bootstrapNode]; !ok {
+			ln.log.Warn("node %q won't bootstrap from node %q, which isn't in the network", nodeConfig.Name, bootstrapNode)
+		}
+	}
+	if ln.p2pProxy {
+		return ln.addProxyLinks(nodeConfig.Name, nodeConfig.BootstrapNodes)
+	}
+	if len(nodeConfig.BootstrapNodes) == 0 {
+		return ln.bootstraps, nil
+	}
+	bootstraps := beacon.NewSet()
+	added := make(map[string]bool, len(nodeConfig.BootstrapNodes))
+	for _, bootstrapNode := range nodeConfig.BootstrapNodes {
+		node, ok := ln.nodes[bootstrapNode]
+		if !ok || added[bootstrapNode] {
+			continue
+		}
+		added[bootstrapNode] = true
+		if err := bootstraps.Add(beacon.New(node.nodeID, avago_utils.IPDesc{
+			IP:   node.ip,
+			Port: node.p2pPort,
+		})); err != nil {
+			return nil, err
+		}
+	}
+	return bootstraps, nil
+}
+
+// releaseNode undoes [prepareNode] for [pending], whose process isn't running
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) releaseNode(pending *pendingNode) {
+	ports.release(pending.nodeData.reservedPorts...)
+	ln.removeProxyLinks(pending.config.Name)
+}
 
+// startNodeProcess starts the process of [pending]. If one of the ports
+// allocated to it was bound meanwhile by another process, it's given
+// fresh ports first, up to [maxPortRetries] times.
+// Doesn't change the network, so it can be called concurrently.
+func (ln *localNetwork) startNodeProcess(pending *pendingNode) error {
+	for retries := 0; anyPortBound(pending.nodeData.allocatedPorts); retries++ {
+		if retries == maxPortRetries {
+			return fmt.Errorf("ports %v allocated to node %q are bound by another process", pending.nodeData.allocatedPorts, pending.config.Name)
+		}
+		ln.log.Info("starting node %q on fresh ports, as one of %v is already bound", pending.config.Name, pending.nodeData.allocatedPorts)
+		if err := ln.reallocatePorts(pending); err != nil {
+			return err
+		}
+	}
+
+	nodeConfig, flags := pending.config, pending.nodeData.flags
 	// Start the AvalancheGo node and pass it the flags defined above
 	nodeProcess, err := ln.nodeProcessCreator.NewNodeProcess(nodeConfig, flags...)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't create new node process: %s", err)
+		return fmt.Errorf("couldn't create new node process: %s", err)
 	}
 	ln.log.Debug("starting node %q with \"%s %s\"", nodeConfig.Name, nodeConfig.BinaryPath, flags)
 	if err := nodeProcess.Start(); err != nil {
-		return nil, fmt.Errorf("could not execute cmd \"%s %s\": %w", nodeConfig.BinaryPath, flags, err)
+		return fmt.Errorf("could not execute cmd \"%s %s\": %w", nodeConfig.BinaryPath, flags, err)
+	}
+	host, ip := localHost, net.IPv6loopback
+	if pending.nodeIP != nil {
+		host, ip = pending.nodeIP.String(), pending.nodeIP
 	}
+	if remoteProcess, ok := nodeProcess.(RemoteNodeProcess); ok {
+		host = remoteProcess.GetHost()
+		ip, err = resolveHost(host)
+		if err != nil {
+			_ = nodeProcess.Stop()
+			return err
+		}
+	}
+	pending.process, pending.host, pending.ip = nodeProcess, host, ip
+	return nil
+}
+
+// reallocatePorts replaces the ports allocated to [pending],
+// in its flags too, with newly allocated ones
+func (ln *localNetwork) reallocatePorts(pending *pendingNode) error {
+	nodeData := &pending.nodeData
+	for i, oldPort := range nodeData.allocatedPorts {
+		port, err := ports.allocate(ln.portRange)
+		if err != nil {
+			return err
+		}
+		ports.release(oldPort)
+		for j := range nodeData.reservedPorts {
+			if nodeData.reservedPorts[j] == oldPort {
+				nodeData.reservedPorts[j] = port
+			}
+		}
+		nodeData.allocatedPorts[i] = port
+
+		var portKey string
+		switch oldPort {
+		case nodeData.apiPort:
+			portKey, nodeData.apiPort = config.HTTPPortKey, port
+		case nodeData.p2pPort:
+			portKey, nodeData.p2pPort = config.StakingPortKey, port
+		}
+		oldFlag := fmt.Sprintf("--%s=%d", portKey, oldPort)
+		for j := range nodeData.flags {
+			if nodeData.flags[j] == oldFlag {
+				nodeData.flags[j] = fmt.Sprintf("--%s=%d", portKey, port)
+			}
+		}
+	}
+	return nil
+}
 
+// registerNode adds [pending], whose process was started, to the network
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) registerNode(pending *pendingNode) (*localNode, error) {
+	nodeConfig, nodeData := pending.config, pending.nodeData
 	// Create a wrapper for this node so we can reference it later
 	node := &localNode{
-		name:        nodeConfig.Name,
-		nodeID:      nodeID,
-		networkID:   ln.networkID,
-		client:      ln.newAPIClientF("localhost", apiPort),
-		process:     nodeProcess,
-		apiPort:     apiPort,
-		p2pPort:     p2pPort,
-		getConnFunc: defaultGetConnFunc,
-	}
+		name:             nodeConfig.Name,
+		nodeID:           pending.nodeID,
+		networkID:        ln.networkID,
+		client:           ln.newAPIClientF(pending.host, nodeData.apiPort),
+		process:          pending.process,
+		args:             nodeData.flags,
+		host:             pending.host,
+		ip:               pending.ip,
+		apiPort:          nodeData.apiPort,
+		p2pPort:          nodeData.p2pPort,
+		reservedPorts:    nodeData.reservedPorts,
+		allocatedPorts:   nodeData.allocatedPorts,
+		getConnFunc:      defaultGetConnFunc,
+		dbDir:            nodeData.dbDir,
+		logsDir:          nodeData.logsDir,
+		config:           nodeConfig,
+		stopCh:           make(chan struct{}),
+		supervisorDoneCh: make(chan struct{}),
+	}
+	if ln.p2pProxy {
+		node.proxy = newNodeProxy(ln.log, pending.host, nodeData.p2pPort)
+	}
+	node.processStatus.Running = true
 	ln.nodes[node.name] = node
+	ln.writePIDFile(node, pending.process)
+	go ln.superviseNode(node)
+	go ln.sampleResources(node)
+	if node.proxy != nil {
+		go ln.checkProxyBypasses(node)
+	}
+	ln.events.Publish(network.NewEvent(network.EventNodeAdded, node.name, ""))
+	ln.events.Publish(network.NewEvent(network.EventNodeStarted, node.name, ""))
 	// If this node is a beacon, add its IP/ID to the beacon lists.
 	// Note that we do this *after* we set this node's bootstrap IPs/IDs
 	// so this node won't try to use itself as a beacon.
+	var err error
 	if nodeConfig.IsBeacon {
-		err = ln.bootstraps.Add(beacon.New(nodeID, avago_utils.IPDesc{
-			IP:   net.IPv6loopback,
-			Port: p2pPort,
+		err = ln.bootstraps.Add(beacon.New(pending.nodeID, avago_utils.IPDesc{
+			IP:   pending.ip,
+			Port: nodeData.p2pPort,
 		}))
 	}
 	return node, err
@@ -440,6 +996,40 @@ func (ln *localNetwork) Healthy(ctx context.Context) chan error {
 	defer ln.lock.RUnlock()
 
 	zap.L().Info("checking local network healthiness", zap.Int("nodes", len(ln.nodes)))
+	nodes := make([]*localNode, 0, len(ln.nodes))
+	for _, node := range ln.nodes {
+		nodes = append(nodes, node)
+	}
+	return ln.waitForNodes(ctx, nodes)
+}
+
+// See network.Network
+func (ln *localNetwork) WaitForNodes(ctx context.Context, nodeNames ...string) chan error {
+	ln.lock.RLock()
+	defer ln.lock.RUnlock()
+
+	if ln.isStopped() {
+		// waitForNodes reports it
+		return ln.waitForNodes(ctx, nil)
+	}
+	nodes := make([]*localNode, 0, len(nodeNames))
+	for _, nodeName := range nodeNames {
+		node, ok := ln.nodes[nodeName]
+		if !ok {
+			healthyChan := make(chan error, 1)
+			healthyChan <- fmt.Errorf("node %q not found", nodeName)
+			return healthyChan
+		}
+		nodes = append(nodes, node)
+	}
+	return ln.waitForNodes(ctx, nodes)
+}
+
+// waitForNodes returns a channel that is closed once all of [nodes] are healthy,
+// or that gets an error if one of them doesn't become healthy.
+// See network.Network.Healthy.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) waitForNodes(ctx context.Context, nodes []*localNode) chan error {
 	healthyChan := make(chan error, 1)
 
 	// Return unhealthy if the network is stopped
@@ -448,10 +1038,8 @@ func (ln *localNetwork) Healthy(ctx context.Context) chan error {
 		return healthyChan
 	}
 
-	nodes := make([]*localNode, 0, len(ln.nodes))
-	for _, node := range ln.nodes {
-		nodes = append(nodes, node)
-	}
+	// [ln.closedOnStopCh] is replaced when a snapshot is loaded
+	closedOnStopCh := ln.closedOnStopCh
 	go func() {
 		errGr, cctx := errgroup.WithContext(ctx)
 		for _, node := range nodes {
@@ -460,18 +1048,27 @@ func (ln *localNetwork) Healthy(ctx context.Context) chan error {
 				// Every constants.HealthCheckInterval, query node for health status.
 				// Do this until ctx timeout
 				for {
+					report, err := ln.checkNodeHealth(cctx, node)
+					if err != nil {
+						return ln.nodeUnhealthy(node, err)
+					}
+					if report.Healthy {
+						ln.log.Debug("node %q became healthy", node.name)
+						ln.events.Publish(network.NewEvent(network.EventNodeHealthy, node.name, ""))
+						return nil
+					}
 					select {
-					case <-ln.closedOnStopCh:
+					case <-closedOnStopCh:
 						return network.ErrStopped
 					case <-cctx.Done():
-						return fmt.Errorf("node %q failed to become healthy within timeout", node.GetName())
+						err := fmt.Errorf("node %q failed to become healthy within timeout", node.GetName())
+						if ctx.Err() == nil {
+							// Cancelled because another node failed
+							return err
+						}
+						return ln.nodeUnhealthy(node, err)
 					case <-time.After(healthCheckFreq):
 					}
-					health, err := node.client.HealthAPI().Health(cctx)
-					if err == nil && health.Healthy {
-						ln.log.Debug("node %q became healthy", node.name)
-						return nil
-					}
 				}
 			})
 		}
@@ -484,6 +1081,71 @@ func (ln *localNetwork) Healthy(ctx context.Context) chan error {
 	return healthyChan
 }
 
+// See network.Network
+func (ln *localNetwork) NodeHealth(ctx context.Context, nodeName string) (network.HealthReport, error) {
+	ln.lock.RLock()
+	if ln.isStopped() {
+		ln.lock.RUnlock()
+		return network.HealthReport{}, network.ErrStopped
+	}
+	node, ok := ln.nodes[nodeName]
+	ln.lock.RUnlock()
+	if !ok {
+		return network.HealthReport{}, fmt.Errorf("node %q not found", nodeName)
+	}
+
+	// Don't hold the lock while the node is queried
+	report, _ := ln.checkNodeHealth(ctx, node)
+	return report, nil
+}
+
+// See network.Network
+func (ln *localNetwork) GetHealthReports() (map[string]network.HealthReport, error) {
+	ln.lock.RLock()
+	defer ln.lock.RUnlock()
+
+	if ln.isStopped() {
+		return nil, network.ErrStopped
+	}
+	reports := make(map[string]network.HealthReport, len(ln.nodes))
+	for nodeName, node := range ln.nodes {
+		reports[nodeName] = node.getHealthReport()
+	}
+	return reports, nil
+}
+
+// checkNodeHealth queries the health API of [node], records
+// its report, and returns it.
+// If [node] is paused, or its process exited and won't be restarted, it isn't
+// queried, and an error wrapping network.ErrNodePaused or network.ErrNodeExited
+// is returned along with the report, as the node can't become healthy.
+func (ln *localNetwork) checkNodeHealth(ctx context.Context, node *localNode) (network.HealthReport, error) {
+	var err error
+	if node.GetPaused() {
+		err = fmt.Errorf("node %q: %w", node.name, network.ErrNodePaused)
+	} else if status := node.GetProcessStatus(); !status.Running && !status.Restarting {
+		err = fmt.Errorf("node %q: %w", node.name, network.ErrNodeExited)
+	}
+	if err != nil {
+		report := network.HealthReport{NodeName: node.name, Time: time.Now(), Error: err.Error()}
+		node.setHealthReport(report)
+		return report, err
+	}
+
+	reply, err := node.client.HealthAPI().Health(ctx)
+	if err != nil {
+		report := network.HealthReport{NodeName: node.name, Time: time.Now(), Error: err.Error()}
+		// The failure of a cancelled query says nothing about the node
+		if ctx.Err() == nil {
+			node.setHealthReport(report)
+		}
+		return report, nil
+	}
+	report := network.NewHealthReport(node.name, reply)
+	node.setHealthReport(report)
+	return report, nil
+}
+
 // See network.Network
 func (ln *localNetwork) GetNode(nodeName string) (node.Node, error) {
 	ln.lock.RLock()
@@ -549,23 +1211,42 @@ func (ln *localNetwork) stop(ctx context.Context) error {
 	}
 	ctx, cancel := context.WithTimeout(ctx, stopTimeout)
 	defer cancel()
-	errs := wrappers.Errs{}
+	// Closed first, so that the goroutines waiting on it return
+	// even if the nodes aren't stopped within the timeout
+	close(ln.closedOnStopCh)
+
+	// All the nodes are signaled at once, then waited for together
+	var (
+		errsLock sync.Mutex
+		errs     = wrappers.Errs{}
+		errGr    = errgroup.Group{}
+	)
 	for nodeName := range ln.nodes {
-		select {
-		case <-ctx.Done():
-			// In practice we'll probably never time out here,
-			// and the caller probably won't cancel a call
-			// to stop(), but we include this to respect the
-			// network.Network interface.
-			return ctx.Err()
-		default:
-		}
-		if err := ln.removeNode(nodeName); err != nil {
-			ln.log.Error("error stopping node %q: %s", nodeName, err)
-			errs.Add(err)
-		}
+		node := ln.detachNode(nodeName)
+		errGr.Go(func() error {
+			if err := ln.stopNode(node); err != nil {
+				ln.log.Error("error stopping node %q: %s", node.name, err)
+				errsLock.Lock()
+				errs.Add(err)
+				errsLock.Unlock()
+			}
+			return nil
+		})
+	}
+	stoppedCh := make(chan struct{})
+	go func() {
+		_ = errGr.Wait()
+		close(stoppedCh)
+	}()
+	select {
+	case <-stoppedCh:
+	case <-ctx.Done():
+		// In practice we'll probably never time out here,
+		// and the caller probably won't cancel a call
+		// to stop(), but we include this to respect the
+		// network.Network interface.
+		return ctx.Err()
 	}
-	close(ln.closedOnStopCh)
 	ln.log.Info("done stopping network")
 	return errs.Err
 }
@@ -584,27 +1265,263 @@ func (ln *localNetwork) removeNode(nodeName string) error {
 		return network.ErrStopped
 	}
 	ln.log.Debug("removing node %q", nodeName)
-	node, ok := ln.nodes[nodeName]
-	if !ok {
+	if _, ok := ln.nodes[nodeName]; !ok {
 		return fmt.Errorf("node %q not found", nodeName)
 	}
+	return ln.stopNode(ln.detachNode(nodeName))
+}
 
+// detachNode removes node [nodeName], which must exist,
+// from the network, and returns it
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) detachNode(nodeName string) *localNode {
+	node := ln.nodes[nodeName]
 	// If the node wasn't a beacon, we don't care
 	_ = ln.bootstraps.RemoveByID(node.nodeID)
-
+	if node.proxy != nil {
+		node.proxy.close()
+	}
+	ln.removeProxyLinks(nodeName)
 	delete(ln.nodes, nodeName)
+	return node
+}
+
+// stopNode stops [node], detached from the network, and releases its ports.
+// Doesn't change the network, so it can be called concurrently.
+func (ln *localNetwork) stopNode(node *localNode) error {
+	nodeName := node.name
 	// cchain eth api uses a websocket connection and must be closed before stopping the node,
 	// to avoid errors logs at client
 	node.client.CChainEthAPI().Close()
-	if err := node.process.Stop(); err != nil {
-		return fmt.Errorf("error sending SIGTERM to node %s: %w", nodeName, err)
+	if err := node.stop(); err != nil {
+		return fmt.Errorf("error stopping node %s: %w", nodeName, err)
+	}
+	// The supervisor owns the wait on the process
+	select {
+	case <-node.supervisorDoneCh:
+	case <-time.After(ln.stopGracePeriod):
+		ln.log.Warn("node %q didn't exit within %s of SIGTERM, sending SIGKILL", nodeName, ln.stopGracePeriod)
+		if err := node.kill(); err != nil {
+			return fmt.Errorf("error killing node %s: %w", nodeName, err)
+		}
+		<-node.supervisorDoneCh
 	}
-	if err := node.process.Wait(); err != nil {
+	ports.release(node.reservedPorts...)
+	ln.events.Publish(network.NewEvent(network.EventNodeRemoved, nodeName, ""))
+	// The exit status of adopted processes is unknown
+	if err := node.getExitErr(); err != nil && !errors.Is(err, errAdoptedProcessExited) {
 		return fmt.Errorf("node %q stopped with error: %w", nodeName, err)
 	}
 	return nil
 }
 
+// See network.Network
+func (ln *localNetwork) SetBeacon(nodeName string, isBeacon bool) error {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.isStopped() {
+		return network.ErrStopped
+	}
+	node, ok := ln.nodes[nodeName]
+	if !ok {
+		return fmt.Errorf("node %q not found", nodeName)
+	}
+	if node.config.IsBeacon == isBeacon {
+		return nil
+	}
+	if isBeacon {
+		if err := ln.bootstraps.Add(beacon.New(node.nodeID, avago_utils.IPDesc{
+			IP:   node.ip,
+			Port: node.p2pPort,
+		})); err != nil {
+			return err
+		}
+		ln.log.Info("node %q is now a beacon", nodeName)
+	} else {
+		_ = ln.bootstraps.RemoveByID(node.nodeID)
+		ln.log.Info("node %q isn't a beacon anymore", nodeName)
+	}
+	node.lock.Lock()
+	node.config.IsBeacon = isBeacon
+	node.lock.Unlock()
+	return nil
+}
+
+// nodeUnhealthy publishes a node-unhealthy event for [node]
+// and returns [err], the reason the node isn't healthy.
+func (ln *localNetwork) nodeUnhealthy(node *localNode, err error) error {
+	ln.events.Publish(network.NewEvent(network.EventNodeUnhealthy, node.name, err.Error()))
+	return err
+}
+
+// See network.Network
+func (ln *localNetwork) SubscribeEvents() (<-chan network.Event, func()) {
+	return ln.events.Subscribe()
+}
+
+// See network.Network
+func (ln *localNetwork) Partition(groups [][]string) error {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.isStopped() {
+		return network.ErrStopped
+	}
+	if !ln.p2pProxy {
+		return network.ErrNoP2PProxy
+	}
+	partition := map[string]int{}
+	for i, group := range groups {
+		for _, nodeName := range group {
+			if _, ok := ln.nodes[nodeName]; !ok {
+				return fmt.Errorf("node %q not found", nodeName)
+			}
+			if _, ok := partition[nodeName]; ok {
+				return fmt.Errorf("node %q is in more than one group", nodeName)
+			}
+			partition[nodeName] = i
+		}
+	}
+	ln.log.Info("partitioning network in groups %v", groups)
+	ln.partition = partition
+	ln.applyPartition()
+	return nil
+}
+
+// See network.Network
+func (ln *localNetwork) Heal() error {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.isStopped() {
+		return network.ErrStopped
+	}
+	if !ln.p2pProxy {
+		return network.ErrNoP2PProxy
+	}
+	ln.log.Info("healing network partition")
+	ln.partition = nil
+	ln.applyPartition()
+	return nil
+}
+
+// See network.Network
+func (ln *localNetwork) SetNodeFaults(nodeName string, faults network.LinkFaults) error {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if err := ln.checkFaults(faults, nodeName); err != nil {
+		return err
+	}
+	ln.log.Info("setting faults of node %s to %+v", nodeName, faults)
+	if faults.IsZero() {
+		delete(ln.nodeFaults, nodeName)
+	} else {
+		if ln.nodeFaults == nil {
+			ln.nodeFaults = map[string]network.LinkFaults{}
+		}
+		ln.nodeFaults[nodeName] = faults
+	}
+	ln.applyFaults()
+	return nil
+}
+
+// See network.Network
+func (ln *localNetwork) SetLinkFaults(nodeName1 string, nodeName2 string, faults network.LinkFaults) error {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if err := ln.checkFaults(faults, nodeName1, nodeName2); err != nil {
+		return err
+	}
+	if nodeName1 == nodeName2 {
+		return fmt.Errorf("node %q can't have a link to itself", nodeName1)
+	}
+	ln.log.Info("setting faults between nodes %s and %s to %+v", nodeName1, nodeName2, faults)
+	key := newLinkKey(nodeName1, nodeName2)
+	if faults.IsZero() {
+		delete(ln.linkFaults, key)
+	} else {
+		if ln.linkFaults == nil {
+			ln.linkFaults = map[linkKey]network.LinkFaults{}
+		}
+		ln.linkFaults[key] = faults
+	}
+	ln.applyFaults()
+	return nil
+}
+
+// checkFaults returns an error if faults can't be injected in the
+// P2P traffic of nodes [nodeNames]
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) checkFaults(faults network.LinkFaults, nodeNames ...string) error {
+	if ln.isStopped() {
+		return network.ErrStopped
+	}
+	if !ln.p2pProxy {
+		return network.ErrNoP2PProxy
+	}
+	if err := faults.Validate(); err != nil {
+		return err
+	}
+	for _, nodeName := range nodeNames {
+		if _, ok := ln.nodes[nodeName]; !ok {
+			return fmt.Errorf("node %q not found", nodeName)
+		}
+	}
+	return nil
+}
+
+// Sends a SIGSTOP to the given node
+func (ln *localNetwork) PauseNode(nodeName string) error {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.isStopped() {
+		return network.ErrStopped
+	}
+	node, ok := ln.nodes[nodeName]
+	if !ok {
+		return fmt.Errorf("node %q not found", nodeName)
+	}
+	if node.GetPaused() {
+		return fmt.Errorf("node %q is already paused", nodeName)
+	}
+	ln.log.Debug("pausing node %q", nodeName)
+	if !node.GetProcessStatus().Running {
+		return fmt.Errorf("node %q: %w", nodeName, network.ErrNodeExited)
+	}
+	if err := node.getProcess().Pause(); err != nil {
+		return fmt.Errorf("error sending SIGSTOP to node %s: %w", nodeName, err)
+	}
+	node.setPaused(true)
+	return nil
+}
+
+// Sends a SIGCONT to the given node
+func (ln *localNetwork) ResumeNode(nodeName string) error {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.isStopped() {
+		return network.ErrStopped
+	}
+	node, ok := ln.nodes[nodeName]
+	if !ok {
+		return fmt.Errorf("node %q not found", nodeName)
+	}
+	if !node.GetPaused() {
+		return fmt.Errorf("node %q is not paused", nodeName)
+	}
+	ln.log.Debug("resuming node %q", nodeName)
+	if err := node.getProcess().Resume(); err != nil {
+		return fmt.Errorf("error sending SIGCONT to node %s: %w", nodeName, err)
+	}
+	node.setPaused(false)
+	return nil
+}
+
 // Assumes [net.lock] is held
 func (ln *localNetwork) isStopped() bool {
 	select {
@@ -696,75 +1613,144 @@ func getConfigEntry(configFile map[string]interface{}, flag string, defaultVal s
 	return defaultVal, nil
 }
 
-// getPort looks up the port config in the config file, if there is none, it tries to get a random free port from the OS
+// getPort looks up the port config in the flags, then in the config file.
+// If there is none, it allocates a free port of [portRange], and returns
+// true as [allocated]. The caller must release an allocated port.
 func getPort(
 	flags map[string]interface{},
 	configFile map[string]interface{},
 	portKey string,
-) (port uint16, err error) {
+	portRange network.PortRange,
+) (port uint16, allocated bool, err error) {
 	if portIntf, ok := flags[portKey]; ok {
-		if portFromFlags, ok := portIntf.(int); ok {
+		switch portFromFlags := portIntf.(type) {
+		case int:
 			port = uint16(portFromFlags)
-		} else {
-			return 0, fmt.Errorf("expected flag %q to be int but got %T", portKey, portIntf)
+		case float64:
+			// Flags unmarshalled from JSON (e.g. from a snapshot) are float64
+			port = uint16(portFromFlags)
+		default:
+			return 0, false, fmt.Errorf("expected flag %q to be int but got %T", portKey, portIntf)
 		}
 	} else if portIntf, ok := configFile[portKey]; ok {
 		if portFromConfigFile, ok := portIntf.(float64); ok {
 			port = uint16(portFromConfigFile)
 		} else {
-			return 0, fmt.Errorf("expected flag %q to be float64 but got %T", portKey, portIntf)
+			return 0, false, fmt.Errorf("expected flag %q to be float64 but got %T", portKey, portIntf)
 		}
 	} else {
-		// Use a random free port.
-		// Note: it is possible but unlikely for getFreePort to return the same port multiple times.
-		port, err = getFreePort()
+		port, err = ports.allocate(portRange)
 		if err != nil {
-			return 0, fmt.Errorf("couldn't get free API port: %w", err)
+			return 0, false, fmt.Errorf("couldn't get free port for %q: %w", portKey, err)
 		}
+		return port, true, nil
 	}
-	return port, nil
+	return port, false, nil
+}
+
+// removeConfigFileKeys returns [configFile] without the entries of [keys].
+// If len([configFile]) == 0, returns it.
+func removeConfigFileKeys(configFile string, keys ...string) (string, error) {
+	if len(configFile) == 0 {
+		return configFile, nil
+	}
+	var configMap map[string]interface{}
+	if err := json.Unmarshal([]byte(configFile), &configMap); err != nil {
+		return "", fmt.Errorf("couldn't unmarshal config file: %w", err)
+	}
+	for _, key := range keys {
+		delete(configMap, key)
+	}
+	configFileBytes, err := json.Marshal(configMap)
+	if err != nil {
+		return "", err
+	}
+	return string(configFileBytes), nil
+}
+
+// buildFlagsReturn is the result of [buildFlags]
+type buildFlagsReturn struct {
+	flags   []string
+	apiPort uint16
+	p2pPort uint16
+	dbDir   string
+	logsDir string
+	// Ports reserved for the node
+	reservedPorts []uint16
+	// Ports allocated to the node, as they
+	// weren't given in its config
+	allocatedPorts []uint16
 }
 
 // buildFlags returns the:
 // 1) Flags
 // 2) API port
 // 3) P2P port
+// 4) DB dir
+// 5) Logs dir
 // of the node being added with config [nodeConfig], config file [configFile],
-// and directory at [nodeDir].
+// and directory at [nodeDir]. The node bootstraps from [bootstraps].
 // [nodeConfig.Flags] must not be nil
 func (ln *localNetwork) buildFlags(
 	configFile map[string]interface{},
 	nodeDir string,
 	nodeConfig *node.Config,
-) ([]string, uint16, uint16, error) {
+	bootstraps beacon.Set,
+	nodeIP net.IP,
+) (buildFlagsReturn, error) {
 	// Add flags in [ln.Flags] to [nodeConfig.Flags]
 	// Assumes [nodeConfig.Flags] is non-nil
 	addNetworkFlags(ln.log, ln.flags, nodeConfig.Flags)
-
-	// Tell the node to put the database in [nodeDir] unless given in config file
-	dbPath, err := getConfigEntry(configFile, config.DBPathKey, nodeDir)
-	if err != nil {
-		return nil, 0, 0, err
+	if ln.p2pProxy {
+		addP2PProxyFlags(configFile, nodeConfig.Flags)
 	}
 
-	// Tell the node to put the log directory in [nodeDir/logs] unless given in config file
-	logsDir, err := getConfigEntry(configFile, config.LogsDirKey, filepath.Join(nodeDir, "logs"))
+	// Tell the node to put the database in [nodeDir] unless given in flags or config file
+	dbPath, err := getConfigEntry(nodeConfig.Flags, config.DBPathKey, "")
 	if err != nil {
-		return nil, 0, 0, err
+		return buildFlagsReturn{}, err
+	}
+	if dbPath == "" {
+		dbPath, err = getConfigEntry(configFile, config.DBPathKey, nodeDir)
+		if err != nil {
+			return buildFlagsReturn{}, err
+		}
 	}
 
-	// Use random free API port unless given in config file
-	apiPort, err := getPort(nodeConfig.Flags, configFile, config.HTTPPortKey)
+	// Tell the node to put the log directory in [nodeDir/logs] unless given in flags or config file
+	logsDir, err := getConfigEntry(nodeConfig.Flags, config.LogsDirKey, "")
 	if err != nil {
-		return nil, 0, 0, err
+		return buildFlagsReturn{}, err
+	}
+	if logsDir == "" {
+		logsDir, err = getConfigEntry(configFile, config.LogsDirKey, filepath.Join(nodeDir, logsSubDir))
+		if err != nil {
+			return buildFlagsReturn{}, err
+		}
 	}
 
-	// Use a random free P2P (staking) port unless given in config file
-	// Use random free API port unless given in config file
-	p2pPort, err := getPort(nodeConfig.Flags, configFile, config.StakingPortKey)
-	if err != nil {
-		return nil, 0, 0, err
+	// Allocate the API and P2P (staking) ports unless given in flags or config file
+	var reservedPorts, allocatedPorts []uint16
+	portsByKey := map[string]uint16{}
+	for _, portKey := range []string{config.HTTPPortKey, config.StakingPortKey} {
+		port, allocated, err := getPort(nodeConfig.Flags, configFile, portKey, ln.portRange)
+		if err != nil {
+			ports.release(reservedPorts...)
+			return buildFlagsReturn{}, err
+		}
+		switch {
+		case allocated:
+			allocatedPorts = append(allocatedPorts, port)
+			reservedPorts = append(reservedPorts, port)
+		case ports.reserve(port):
+			reservedPorts = append(reservedPorts, port)
+		default:
+			ln.log.Warn("port %d of node %q is already used by another node", port, nodeConfig.Name)
+		}
+		portsByKey[portKey] = port
 	}
+	apiPort := portsByKey[config.HTTPPortKey]
+	p2pPort := portsByKey[config.StakingPortKey]
 
 	// Flags for AvalancheGo
 	flags := []string{
@@ -773,20 +1759,31 @@ func (ln *localNetwork) buildFlags(
 		fmt.Sprintf("--%s=%s", config.LogsDirKey, logsDir),
 		fmt.Sprintf("--%s=%d", config.HTTPPortKey, apiPort),
 		fmt.Sprintf("--%s=%d", config.StakingPortKey, p2pPort),
-		fmt.Sprintf("--%s=%s", config.BootstrapIPsKey, ln.bootstraps.IPsArg()),
-		fmt.Sprintf("--%s=%s", config.BootstrapIDsKey, ln.bootstraps.IDsArg()),
+		fmt.Sprintf("--%s=%s", config.BootstrapIPsKey, bootstraps.IPsArg()),
+		fmt.Sprintf("--%s=%s", config.BootstrapIDsKey, bootstraps.IDsArg()),
+	}
+	if nodeIP != nil {
+		flags = append(flags,
+			fmt.Sprintf("--%s=%s", config.PublicIPKey, nodeIP),
+			fmt.Sprintf("--%s=%s", config.HTTPHostKey, nodeIP),
+		)
 	}
 	// Write staking key/cert etc. to disk so the new node can use them,
 	// and get flag that point the node to those files
 	fileFlags, err := writeFiles(ln.genesis, nodeDir, nodeConfig)
 	if err != nil {
-		return nil, 0, 0, err
+		ports.release(reservedPorts...)
+		return buildFlagsReturn{}, err
 	}
 	flags = append(flags, fileFlags...)
 
 	// Add flags given in node config.
 	// Note these will overwrite existing flags if the same flag is given twice.
 	for flagName, flagVal := range nodeConfig.Flags {
+		if flagName == config.DBPathKey || flagName == config.LogsDirKey {
+			// Already given above
+			continue
+		}
 		if _, ok := warnFlags[flagName]; ok {
 			ln.log.Warn("The flag %s has been provided. This can create conflicts with the runner. The suggestion is to remove this flag", flagName)
 		}
@@ -797,7 +1794,15 @@ func (ln *localNetwork) buildFlags(
 		"adding node %q with tmp dir at %s, logs at %s, DB at %s, P2P port %d, API port %d",
 		nodeConfig.Name, nodeDir, logsDir, dbPath, p2pPort, apiPort,
 	)
-	return flags, apiPort, p2pPort, nil
+	return buildFlagsReturn{
+		flags:          flags,
+		apiPort:        apiPort,
+		p2pPort:        p2pPort,
+		dbDir:          dbPath,
+		logsDir:        logsDir,
+		reservedPorts:  reservedPorts,
+		allocatedPorts: allocatedPorts,
+	}, nil
 }
 
 // writeFiles writes the files a node needs on startup.
@@ -845,9 +1850,27 @@ func writeFiles(genesis []byte, nodeRootDir string, nodeConfig *node.Config) ([]
 			contents:  []byte(nodeConfig.CChainConfigFile),
 		})
 	}
+	chains := make([]string, 0, len(nodeConfig.ChainConfigFiles))
+	for chain := range nodeConfig.ChainConfigFiles {
+		chains = append(chains, chain)
+	}
+	sort.Strings(chains)
+	for _, chain := range chains {
+		files = append(files, file{
+			flagValue: filepath.Join(nodeRootDir, chainConfigSubDir),
+			path:      filepath.Join(nodeRootDir, chainConfigSubDir, chain, configFileName),
+			pathKey:   config.ChainConfigDirKey,
+			contents:  []byte(nodeConfig.ChainConfigFiles[chain]),
+		})
+	}
 	flags := []string{}
+	flagKeys := make(map[string]struct{}, len(files))
 	for _, f := range files {
-		flags = append(flags, fmt.Sprintf("--%s=%s", f.pathKey, f.flagValue))
+		// Chain configs share the flag of their dir
+		if _, ok := flagKeys[f.pathKey]; !ok {
+			flagKeys[f.pathKey] = struct{}{}
+			flags = append(flags, fmt.Sprintf("--%s=%s", f.pathKey, f.flagValue))
+		}
 		if err := createFileAndWrite(f.path, f.contents); err != nil {
 			return nil, fmt.Errorf("couldn't write file at %q: %w", f.path, err)
 		}
@@ -5,10 +5,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -20,6 +23,8 @@ import (
 	"github.com/ava-labs/avalanche-network-runner/utils"
 	"github.com/ava-labs/avalanchego/api/health"
 	healthmocks "github.com/ava-labs/avalanchego/api/health/mocks"
+	"github.com/ava-labs/avalanchego/api/info"
+	infomocks "github.com/ava-labs/avalanchego/api/info/mocks"
 	"github.com/ava-labs/avalanchego/config"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
@@ -37,6 +42,9 @@ var (
 	_ NodeProcessCreator    = &localTestFailedStartProcessCreator{}
 	_ NodeProcessCreator    = &localTestProcessUndefNodeProcessCreator{}
 	_ NodeProcessCreator    = &localTestFlagCheckProcessCreator{}
+	_ NodeProcessCreator    = &localTestCrashingProcessCreator{}
+	_ NodeProcessCreator    = &localTestPortConflictProcessCreator{}
+	_ NodeProcessCreator    = &localTestHangingProcessCreator{}
 	_ api.NewAPIClientF     = newMockAPISuccessful
 	_ api.NewAPIClientF     = newMockAPIUnhealthy
 	_ router.InboundHandler = &noOpInboundHandler{}
@@ -58,6 +66,148 @@ func (*localTestFailedStartProcessCreator) NewNodeProcess(config node.Config, fl
 	return process, nil
 }
 
+// Creates processes that exit with an error right after being
+// started, until [crashes] of them were created. Then creates
+// successful processes.
+type localTestCrashingProcessCreator struct {
+	lock    sync.Mutex
+	crashes int
+}
+
+func (lt *localTestCrashingProcessCreator) NewNodeProcess(config node.Config, flags ...string) (NodeProcess, error) {
+	lt.lock.Lock()
+	defer lt.lock.Unlock()
+
+	if lt.crashes == 0 {
+		return newMockProcessSuccessful(config, flags...)
+	}
+	lt.crashes--
+	process := &mocks.NodeProcess{}
+	process.On("Start").Return(nil)
+	process.On("Wait").Return(errors.New("crashed"))
+	process.On("Stop").Return(nil)
+	return process, nil
+}
+
+// Creates a first process that exits with an error once [exitCh]
+// is closed, as if its port was bound meanwhile. Then creates
+// successful processes.
+type localTestPortConflictProcessCreator struct {
+	lock    sync.Mutex
+	exitCh  chan time.Time
+	created bool
+}
+
+func (lt *localTestPortConflictProcessCreator) NewNodeProcess(config node.Config, flags ...string) (NodeProcess, error) {
+	lt.lock.Lock()
+	defer lt.lock.Unlock()
+
+	if lt.created {
+		return newMockProcessSuccessful(config, flags...)
+	}
+	lt.created = true
+	process := &mocks.NodeProcess{}
+	process.On("Start").Return(nil)
+	process.On("Wait").WaitUntil(lt.exitCh).Return(errors.New("address already in use"))
+	process.On("Stop").Return(nil)
+	return process, nil
+}
+
+// Creates successful processes running on [host]
+// localTestHangingProcessCreator creates processes that
+// ignore SIGTERM, and only exit once sent a SIGKILL
+type localTestHangingProcessCreator struct{}
+
+func (*localTestHangingProcessCreator) NewNodeProcess(config node.Config, flags ...string) (NodeProcess, error) {
+	process := &mocks.NodeProcess{}
+	killedCh := make(chan time.Time)
+	killOnce := sync.Once{}
+	process.On("Start").Return(nil)
+	process.On("Wait").Return(&ExitError{ExitCode: -1, ExitSignal: "killed"}).WaitUntil(killedCh)
+	process.On("Stop").Return(nil)
+	process.On("Kill").Return(nil).Run(func(mock.Arguments) {
+		killOnce.Do(func() { close(killedCh) })
+	})
+	return process, nil
+}
+
+// localTestSlowProcessCreator creates processes that take [delay]
+// to start, and to exit once stopped, and records the starts
+type localTestSlowProcessCreator struct {
+	delay time.Duration
+
+	lock sync.Mutex
+	// Number of processes starting, and its maximum
+	starting    int
+	maxStarting int
+	// Names of the nodes, in the order their processes were started
+	started []string
+}
+
+func (lt *localTestSlowProcessCreator) NewNodeProcess(config node.Config, flags ...string) (NodeProcess, error) {
+	process := &mocks.NodeProcess{}
+	stoppedCh := make(chan time.Time)
+	stopOnce := sync.Once{}
+	process.On("Start").Return(nil).Run(func(mock.Arguments) {
+		lt.lock.Lock()
+		lt.starting++
+		if lt.starting > lt.maxStarting {
+			lt.maxStarting = lt.starting
+		}
+		lt.lock.Unlock()
+		time.Sleep(lt.delay)
+		lt.lock.Lock()
+		lt.starting--
+		lt.started = append(lt.started, config.Name)
+		lt.lock.Unlock()
+	})
+	process.On("Wait").Return(nil).WaitUntil(stoppedCh)
+	process.On("Stop").Return(nil).Run(func(mock.Arguments) {
+		stopOnce.Do(func() {
+			time.AfterFunc(lt.delay, func() { close(stoppedCh) })
+		})
+	})
+	return process, nil
+}
+
+type localTestRemoteProcessCreator struct {
+	host string
+}
+
+type localTestRemoteProcess struct {
+	NodeProcess
+	host string
+}
+
+func (p *localTestRemoteProcess) GetHost() string {
+	return p.host
+}
+
+func (lt *localTestRemoteProcessCreator) NewNodeProcess(config node.Config, flags ...string) (NodeProcess, error) {
+	process, err := newMockProcessSuccessful(config, flags...)
+	if err != nil {
+		return nil, err
+	}
+	return &localTestRemoteProcess{NodeProcess: process, host: lt.host}, nil
+}
+
+// Creates successful processes, except for [badBinaryPath],
+// whose processes exit with an error right after being started
+type localTestUpgradeProcessCreator struct {
+	badBinaryPath string
+}
+
+func (lt *localTestUpgradeProcessCreator) NewNodeProcess(config node.Config, flags ...string) (NodeProcess, error) {
+	if config.BinaryPath != lt.badBinaryPath {
+		return newMockProcessSuccessful(config, flags...)
+	}
+	process := &mocks.NodeProcess{}
+	process.On("Start").Return(nil)
+	process.On("Wait").Return(errors.New("unsupported database version"))
+	process.On("Stop").Return(nil)
+	return process, nil
+}
+
 type localTestProcessUndefNodeProcessCreator struct{}
 
 func (*localTestProcessUndefNodeProcessCreator) NewNodeProcess(config node.Config, flags ...string) (NodeProcess, error) {
@@ -79,7 +229,8 @@ func (lt *localTestFlagCheckProcessCreator) NewNodeProcess(config node.Config, f
 // Returns an API client where:
 // * The Health API's Health method always returns healthy
 // * The CChainEthAPI's Close method may be called
-// * Only the above 2 methods may be called
+// * The Info API's Peers method returns no peers
+// * Only the above 3 methods may be called
 // TODO have this method return an API Client that has all
 // APIs and methods implemented
 func newMockAPISuccessful(ipAddr string, port uint16) api.Client {
@@ -89,9 +240,12 @@ func newMockAPISuccessful(ipAddr string, port uint16) api.Client {
 	// ethClient used when removing nodes, to close websocket connection
 	ethClient := &apimocks.EthClient{}
 	ethClient.On("Close").Return()
+	infoClient := &infomocks.Client{}
+	infoClient.On("Peers", mock.Anything).Return(nil, nil)
 	client := &apimocks.Client{}
 	client.On("HealthAPI").Return(healthClient)
 	client.On("CChainEthAPI").Return(ethClient)
+	client.On("InfoAPI").Return(infoClient)
 	return client
 }
 
@@ -105,16 +259,37 @@ func newMockAPIUnhealthy(ipAddr string, port uint16) api.Client {
 	return client
 }
 
+// Returns an API client where the Health API's Health method returns
+// unhealthy the first time, as a node that is starting, and then healthy
+func newMockAPIStarting(ipAddr string, port uint16) api.Client {
+	healthClient := &healthmocks.Client{}
+	healthClient.On("Health", mock.Anything).Return(&health.APIHealthReply{Healthy: false}, nil).Once()
+	healthClient.On("Health", mock.Anything).Return(&health.APIHealthReply{Healthy: true}, nil)
+	ethClient := &apimocks.EthClient{}
+	ethClient.On("Close").Return()
+	client := &apimocks.Client{}
+	client.On("HealthAPI").Return(healthClient)
+	client.On("CChainEthAPI").Return(ethClient)
+	return client
+}
+
 func newMockProcessUndef(node.Config, ...string) (NodeProcess, error) {
 	return &mocks.NodeProcess{}, nil
 }
 
-// Returns a NodeProcess that always returns nil
+// Returns a NodeProcess that always returns nil.
+// Wait blocks until Stop is called.
 func newMockProcessSuccessful(node.Config, ...string) (NodeProcess, error) {
 	process := &mocks.NodeProcess{}
+	stoppedCh := make(chan time.Time)
+	stopOnce := sync.Once{}
 	process.On("Start").Return(nil)
-	process.On("Wait").Return(nil)
-	process.On("Stop").Return(nil)
+	process.On("Wait").Return(nil).WaitUntil(stoppedCh)
+	process.On("Stop").Return(nil).Run(func(mock.Arguments) {
+		stopOnce.Do(func() { close(stoppedCh) })
+	})
+	process.On("Pause").Return(nil)
+	process.On("Resume").Return(nil)
 	return process, nil
 }
 
@@ -134,6 +309,8 @@ func TestNewNetworkEmpty(t *testing.T) {
 		newMockAPISuccessful,
 		&localTestProcessUndefNodeProcessCreator{},
 		"",
+		"",
+		nil,
 	)
 	assert.NoError(err)
 	// Assert that GetNodeNames() returns an empty list
@@ -190,6 +367,8 @@ func TestNewNetworkOneNode(t *testing.T) {
 		newMockAPISuccessful,
 		creator,
 		"",
+		"",
+		nil,
 	)
 	assert.NoError(err)
 
@@ -215,6 +394,8 @@ func TestNewNetworkFailToStartNode(t *testing.T) {
 		newMockAPISuccessful,
 		&localTestFailedStartProcessCreator{},
 		"",
+		"",
+		nil,
 	)
 	assert.Error(err)
 }
@@ -445,11 +626,25 @@ func TestWrongNetworkConfigs(t *testing.T) {
 				},
 			},
 		},
+		"invalid port range": {
+			config: network.Config{
+				Genesis: "{\"networkID\": 0}",
+				NodeConfigs: []node.Config{
+					{
+						BinaryPath:  "pepe",
+						IsBeacon:    true,
+						StakingKey:  refNetworkConfig.NodeConfigs[0].StakingKey,
+						StakingCert: refNetworkConfig.NodeConfigs[0].StakingCert,
+					},
+				},
+				PortRange: network.PortRange{Min: 20000, Max: 10000},
+			},
+		},
 	}
 	assert := assert.New(t)
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			_, err := newNetwork(logging.NoLog{}, tt.config, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "")
+			_, err := newNetwork(logging.NoLog{}, tt.config, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
 			assert.Error(err)
 		})
 	}
@@ -461,7 +656,7 @@ func TestUnhealthyNetwork(t *testing.T) {
 	t.Parallel()
 	assert := assert.New(t)
 	networkConfig := testNetworkConfig(t)
-	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPIUnhealthy, &localTestSuccessfulNodeProcessCreator{}, "")
+	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPIUnhealthy, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
 	assert.NoError(err)
 	assert.Error(awaitNetworkHealthy(net, defaultHealthyTimeout))
 }
@@ -475,7 +670,7 @@ func TestGeneratedNodesNames(t *testing.T) {
 	for i := range networkConfig.NodeConfigs {
 		networkConfig.NodeConfigs[i].Name = ""
 	}
-	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "")
+	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
 	assert.NoError(err)
 	nodeNameMap := make(map[string]bool)
 	nodeNames, err := net.GetNodeNames()
@@ -540,7 +735,7 @@ func TestNetworkFromConfig(t *testing.T) {
 	t.Parallel()
 	assert := assert.New(t)
 	networkConfig := testNetworkConfig(t)
-	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "")
+	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
 	assert.NoError(err)
 	assert.NoError(awaitNetworkHealthy(net, defaultHealthyTimeout))
 	runningNodes := make(map[string]struct{})
@@ -550,6 +745,30 @@ func TestNetworkFromConfig(t *testing.T) {
 	checkNetwork(t, net, runningNodes, nil)
 }
 
+// TestCChainAllocations checks that the C-Chain allocations
+// of the network config are added to the genesis of the nodes
+func TestCChainAllocations(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	networkConfig := testNetworkConfig(t)
+	networkConfig.CChainAllocations = []network.CChainAllocation{
+		{Address: "0x0100000000000000000000000000000000000000", Code: []byte{0x60, 0x80}, Nonce: 1},
+	}
+	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
+	assert.NoError(err)
+	defer func() {
+		_ = net.Stop(context.Background())
+	}()
+	ln, ok := net.(*localNetwork)
+	assert.True(ok)
+	assert.Contains(string(ln.genesis), "0x0100000000000000000000000000000000000000")
+	assert.Contains(string(ln.genesis), "0x6080")
+
+	networkConfig.CChainAllocations[0].Address = "0x1234"
+	_, err = newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
+	assert.Error(err)
+}
+
 // TestNetworkNodeOps creates an empty network,
 // adds nodes one by one, then removes nodes one by one.
 // Setween all operations, a network check is performed
@@ -562,7 +781,7 @@ func TestNetworkNodeOps(t *testing.T) {
 	// Start a new, empty network
 	emptyNetworkConfig, err := emptyNetworkConfig()
 	assert.NoError(err)
-	net, err := newNetwork(logging.NoLog{}, emptyNetworkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "")
+	net, err := newNetwork(logging.NoLog{}, emptyNetworkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
 	assert.NoError(err)
 	runningNodes := make(map[string]struct{})
 
@@ -598,7 +817,7 @@ func TestNodeNotFound(t *testing.T) {
 	emptyNetworkConfig, err := emptyNetworkConfig()
 	assert.NoError(err)
 	networkConfig := testNetworkConfig(t)
-	net, err := newNetwork(logging.NoLog{}, emptyNetworkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "")
+	net, err := newNetwork(logging.NoLog{}, emptyNetworkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
 	assert.NoError(err)
 	_, err = net.AddNode(networkConfig.NodeConfigs[0])
 	assert.NoError(err)
@@ -629,7 +848,7 @@ func TestStoppedNetwork(t *testing.T) {
 	emptyNetworkConfig, err := emptyNetworkConfig()
 	assert.NoError(err)
 	networkConfig := testNetworkConfig(t)
-	net, err := newNetwork(logging.NoLog{}, emptyNetworkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "")
+	net, err := newNetwork(logging.NoLog{}, emptyNetworkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
 	assert.NoError(err)
 	_, err = net.AddNode(networkConfig.NodeConfigs[0])
 	assert.NoError(err)
@@ -661,7 +880,7 @@ func TestGetAllNodes(t *testing.T) {
 	t.Parallel()
 	assert := assert.New(t)
 	networkConfig := testNetworkConfig(t)
-	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "")
+	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
 	assert.NoError(err)
 
 	nodes, err := net.GetAllNodes()
@@ -704,6 +923,8 @@ func TestFlags(t *testing.T) {
 		assert: assert,
 	},
 		"",
+		"",
+		nil,
 	)
 	if ok := assert.NoError(err); !ok {
 		t.Fatal("assertion failed")
@@ -728,6 +949,8 @@ func TestFlags(t *testing.T) {
 		assert:        assert,
 	},
 		"",
+		"",
+		nil,
 	)
 	if ok := assert.NoError(err); !ok {
 		t.Fatal("assertion failed")
@@ -751,6 +974,8 @@ func TestFlags(t *testing.T) {
 		assert:        assert,
 	},
 		"",
+		"",
+		nil,
 	)
 	assert.NoError(err)
 	err = nw.Stop(context.Background())
@@ -1028,39 +1253,48 @@ func TestGetPort(t *testing.T) {
 	assert := assert.New(t)
 
 	// Case: port key present in config file
-	port, err := getPort(
+	port, allocated, err := getPort(
 		map[string]interface{}{},
 		map[string]interface{}{"flag": float64(13)},
 		"flag",
+		network.PortRange{},
 	)
 	assert.NoError(err)
 	assert.Equal(uint16(13), port)
+	assert.False(allocated)
 
 	// Case: port key present in flags
-	port, err = getPort(
+	port, allocated, err = getPort(
 		map[string]interface{}{"flag": 13},
 		map[string]interface{}{},
 		"flag",
+		network.PortRange{},
 	)
 	assert.NoError(err)
 	assert.Equal(uint16(13), port)
+	assert.False(allocated)
 
 	// Case: port key present in config file and flags
-	port, err = getPort(
+	port, allocated, err = getPort(
 		map[string]interface{}{"flag": 13},
 		map[string]interface{}{"flag": float64(14)},
 		"flag",
+		network.PortRange{},
 	)
 	assert.NoError(err)
 	assert.Equal(uint16(13), port)
+	assert.False(allocated)
 
 	// Case: port key not present
-	_, err = getPort(
+	port, allocated, err = getPort(
 		map[string]interface{}{},
 		map[string]interface{}{},
 		"flag",
+		network.PortRange{},
 	)
 	assert.NoError(err)
+	assert.True(allocated)
+	ports.release(port)
 }
 
 func TestCreateFileAndWrite(t *testing.T) {
@@ -1084,6 +1318,7 @@ func TestWriteFiles(t *testing.T) {
 	genesis := []byte("genesis")
 	configFile := "config file"
 	cChainConfigFile := "c-chain config file"
+	xChainConfigFile := "x-chain config file"
 	tmpDir, err := os.MkdirTemp("", "avalanche-network-runner-tests-*")
 	if err != nil {
 		t.Fatal(err)
@@ -1098,6 +1333,7 @@ func TestWriteFiles(t *testing.T) {
 	configFileFlag := fmt.Sprintf("--%s=%v", config.ConfigFileKey, configFilePath)
 	chainConfigDir := filepath.Join(tmpDir, chainConfigSubDir)
 	cChainConfigPath := filepath.Join(tmpDir, chainConfigSubDir, "C", configFileName)
+	xChainConfigPath := filepath.Join(tmpDir, chainConfigSubDir, "X", configFileName)
 	chainConfigDirFlag := fmt.Sprintf("--%s=%v", config.ChainConfigDirKey, chainConfigDir)
 
 	type test struct {
@@ -1157,6 +1393,23 @@ func TestWriteFiles(t *testing.T) {
 				chainConfigDirFlag,
 			},
 		},
+		{
+			name:      "c-chain and x-chain config files given",
+			shouldErr: false,
+			genesis:   genesis,
+			nodeConfig: node.Config{
+				StakingKey:       stakingKey,
+				StakingCert:      stakingCert,
+				CChainConfigFile: cChainConfigFile,
+				ChainConfigFiles: map[string]string{"X": xChainConfigFile},
+			},
+			expectedFlags: []string{
+				stakingKeyFlag,
+				stakingCertFlag,
+				genesisFlag,
+				chainConfigDirFlag,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1190,6 +1443,11 @@ func TestWriteFiles(t *testing.T) {
 				assert.NoError(err)
 				assert.Equal([]byte(cChainConfigFile), gotCChainConfigFile)
 			}
+			if len(tt.nodeConfig.ChainConfigFiles) > 0 {
+				gotXChainConfigFile, err := os.ReadFile(xChainConfigPath)
+				assert.NoError(err)
+				assert.Equal([]byte(xChainConfigFile), gotXChainConfigFile)
+			}
 		})
 	}
 }
@@ -1201,7 +1459,7 @@ func TestRemoveBeacon(t *testing.T) {
 	// create a network with no nodes in it
 	emptyNetworkConfig, err := emptyNetworkConfig()
 	assert.NoError(err)
-	netIntf, err := newNetwork(logging.NoLog{}, emptyNetworkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "")
+	netIntf, err := newNetwork(logging.NoLog{}, emptyNetworkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
 	assert.NoError(err)
 	net := netIntf.(*localNetwork)
 
@@ -1216,3 +1474,1034 @@ func TestRemoveBeacon(t *testing.T) {
 	assert.NoError(err)
 	assert.Equal(0, net.bootstraps.Len())
 }
+
+// TestSnapshot saves a snapshot of a network, checks that the network
+// is stopped, and then restores it, checking the nodes and their databases
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	networkConfig := testNetworkConfig(t)
+	rootDir := t.TempDir()
+	snapshotsDir := t.TempDir()
+	netIntf, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, rootDir, snapshotsDir, nil)
+	assert.NoError(err)
+	net := netIntf.(*localNetwork)
+
+	// write some fake database contents for each node
+	networkName := constants.NetworkName(net.networkID)
+	for _, nodeConfig := range networkConfig.NodeConfigs {
+		dbFilePath := filepath.Join(net.nodes[nodeConfig.Name].dbDir, networkName, "db.dat")
+		assert.NoError(createFileAndWrite(dbFilePath, []byte(nodeConfig.Name)))
+	}
+
+	snapshotPath, err := net.SaveSnapshot(context.Background(), "snapshot0")
+	assert.NoError(err)
+	assert.Equal(filepath.Join(snapshotsDir, "snapshot0"), snapshotPath)
+	// the network is stopped after saving a snapshot
+	_, err = net.GetNodeNames()
+	assert.EqualValues(network.ErrStopped, err)
+	// snapshot names must be unique
+	_, err = net.SaveSnapshot(context.Background(), "snapshot0")
+	assert.Error(err)
+
+	snapshotNames, err := net.GetSnapshotNames()
+	assert.NoError(err)
+	assert.Equal([]string{"snapshot0"}, snapshotNames)
+
+	// restore into a new root dir
+	newRootDir := t.TempDir()
+	newNetIntf, err := newNetworkFromSnapshot(logging.NoLog{}, "snapshot0", newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, newRootDir, snapshotsDir, "pepito2", nil)
+	assert.NoError(err)
+	assert.NoError(awaitNetworkHealthy(newNetIntf, defaultHealthyTimeout))
+	newNet := newNetIntf.(*localNetwork)
+	assert.Equal(net.networkID, newNet.networkID)
+	assert.Len(newNet.nodes, len(networkConfig.NodeConfigs))
+	for _, nodeConfig := range networkConfig.NodeConfigs {
+		node, ok := newNet.nodes[nodeConfig.Name]
+		assert.True(ok)
+		assert.Equal(filepath.Join(newRootDir, nodeConfig.Name), node.GetDbDir())
+		assert.Equal("pepito2", node.GetConfig().BinaryPath)
+		assert.Equal(nodeConfig.StakingKey, node.GetConfig().StakingKey)
+		assert.Equal(nodeConfig.StakingCert, node.GetConfig().StakingCert)
+		assert.Equal(nodeConfig.ConfigFile, node.GetConfig().ConfigFile)
+		dbFileContents, err := os.ReadFile(filepath.Join(node.GetDbDir(), networkName, "db.dat"))
+		assert.NoError(err)
+		assert.Equal([]byte(nodeConfig.Name), dbFileContents)
+	}
+
+	// restore in place, on a running network
+	assert.NoError(newNet.LoadSnapshot(context.Background(), "snapshot0"))
+	nodeNames, err := newNet.GetNodeNames()
+	assert.NoError(err)
+	assert.Len(nodeNames, len(networkConfig.NodeConfigs))
+	assert.NoError(awaitNetworkHealthy(newNet, defaultHealthyTimeout))
+
+	// remove the snapshot
+	assert.NoError(newNet.RemoveSnapshot("snapshot0"))
+	snapshotNames, err = newNet.GetSnapshotNames()
+	assert.NoError(err)
+	assert.Len(snapshotNames, 0)
+	assert.Error(newNet.RemoveSnapshot("snapshot0"))
+	assert.Error(newNet.LoadSnapshot(context.Background(), "snapshot0"))
+}
+
+func TestSnapshotInvalidName(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	networkConfig := testNetworkConfig(t)
+	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, t.TempDir(), "", nil)
+	assert.NoError(err)
+	for _, snapshotName := range []string{"", ".", "..", "a/b", "../a"} {
+		_, err := net.SaveSnapshot(context.Background(), snapshotName)
+		assert.Error(err)
+	}
+	// the network isn't stopped if the snapshot name is invalid
+	_, err = net.GetNodeNames()
+	assert.NoError(err)
+}
+
+// TestPauseResumeNode checks that a paused node makes
+// the network unhealthy until it is resumed
+func TestPauseResumeNode(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	networkConfig := testNetworkConfig(t)
+	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
+	assert.NoError(err)
+	nodeName := networkConfig.NodeConfigs[0].Name
+
+	// unknown node
+	assert.Error(net.PauseNode("pepito"))
+	assert.Error(net.ResumeNode("pepito"))
+	// not paused yet
+	assert.Error(net.ResumeNode(nodeName))
+
+	assert.NoError(net.PauseNode(nodeName))
+	node, err := net.GetNode(nodeName)
+	assert.NoError(err)
+	assert.True(node.GetPaused())
+	assert.Error(net.PauseNode(nodeName))
+	assert.ErrorIs(awaitNetworkHealthy(net, defaultHealthyTimeout), network.ErrNodePaused)
+
+	assert.NoError(net.ResumeNode(nodeName))
+	assert.False(node.GetPaused())
+	assert.NoError(awaitNetworkHealthy(net, defaultHealthyTimeout))
+
+	// a paused node is resumed before being stopped
+	assert.NoError(net.PauseNode(nodeName))
+	assert.NoError(net.RemoveNode(nodeName))
+	assert.False(node.GetPaused())
+	process := node.(*localNode).process.(*mocks.NodeProcess)
+	process.AssertNumberOfCalls(t, "Resume", 2)
+
+	assert.NoError(net.Stop(context.Background()))
+	assert.EqualValues(network.ErrStopped, net.PauseNode(networkConfig.NodeConfigs[1].Name))
+	assert.EqualValues(network.ErrStopped, net.ResumeNode(networkConfig.NodeConfigs[1].Name))
+}
+
+// TestNodePortConflict checks that a node whose process exits because
+// one of its allocated ports got bound is restarted on a fresh port
+func TestNodePortConflict(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	networkConfig := testNetworkConfig(t)
+	networkConfig.NodeConfigs = networkConfig.NodeConfigs[:1]
+	creator := &localTestPortConflictProcessCreator{exitCh: make(chan time.Time)}
+	nw, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, creator, "", "", nil)
+	assert.NoError(err)
+	defer func() {
+		_ = nw.Stop(context.Background())
+	}()
+	nodeName := networkConfig.NodeConfigs[0].Name
+	node, err := nw.GetNode(nodeName)
+	assert.NoError(err)
+	p2pPort := node.GetP2PPort()
+
+	// Another process binds the P2P port allocated to the node
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", p2pPort))
+	assert.NoError(err)
+	defer l.Close()
+	close(creator.exitCh)
+
+	assert.Eventually(func() bool {
+		node, err := nw.GetNode(nodeName)
+		return err == nil && node.GetP2PPort() != p2pPort && node.GetProcessStatus().Running
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// TestNodePortTaken checks that a node whose allocated port is bound by
+// another process before its process is started is started on fresh ports
+func TestNodePortTaken(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	networkConfig := testNetworkConfig(t)
+	nodeConfig := networkConfig.NodeConfigs[1]
+	networkConfig.NodeConfigs = networkConfig.NodeConfigs[:1]
+	nw, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
+	assert.NoError(err)
+	defer func() {
+		_ = nw.Stop(context.Background())
+	}()
+	ln := nw.(*localNetwork)
+
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+	pending, err := ln.prepareNode(nodeConfig)
+	assert.NoError(err)
+	p2pPort := pending.nodeData.p2pPort
+
+	// Another process binds the P2P port allocated to the node
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", p2pPort))
+	assert.NoError(err)
+	defer l.Close()
+
+	assert.NoError(ln.startNodeProcess(pending))
+	node, err := ln.registerNode(pending)
+	assert.NoError(err)
+	assert.NotEqual(p2pPort, node.GetP2PPort())
+	assert.Contains(node.args, fmt.Sprintf("--%s=%d", config.StakingPortKey, node.GetP2PPort()))
+	assert.NotContains(node.args, fmt.Sprintf("--%s=%d", config.StakingPortKey, p2pPort))
+	assert.Contains(node.allocatedPorts, node.GetP2PPort())
+	assert.Contains(node.reservedPorts, node.GetP2PPort())
+}
+
+// TestNodeCrash checks that the exits of a node process are recorded,
+// and that the process is restarted according to its restart policy
+func TestNodeCrash(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		restartPolicy    node.RestartPolicy
+		crashes          int
+		expectedRestarts uint32
+		expectedRunning  bool
+	}{
+		"never": {
+			restartPolicy:    node.RestartPolicy{},
+			crashes:          1,
+			expectedRestarts: 0,
+			expectedRunning:  false,
+		},
+		"on-failure": {
+			restartPolicy:    node.RestartPolicy{Mode: node.RestartOnFailure, MaxRetries: 2, Backoff: time.Millisecond},
+			crashes:          2,
+			expectedRestarts: 2,
+			expectedRunning:  true,
+		},
+		"on-failure max retries": {
+			restartPolicy:    node.RestartPolicy{Mode: node.RestartOnFailure, MaxRetries: 2, Backoff: time.Millisecond},
+			crashes:          3,
+			expectedRestarts: 2,
+			expectedRunning:  false,
+		},
+		"always": {
+			restartPolicy:    node.RestartPolicy{Mode: node.RestartAlways, Backoff: time.Millisecond},
+			crashes:          3,
+			expectedRestarts: 3,
+			expectedRunning:  true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert := assert.New(t)
+			networkConfig := testNetworkConfig(t)
+			networkConfig.NodeConfigs = networkConfig.NodeConfigs[:1]
+			networkConfig.NodeConfigs[0].RestartPolicy = tt.restartPolicy
+			creator := &localTestCrashingProcessCreator{crashes: tt.crashes}
+			net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, creator, "", "", nil)
+			assert.NoError(err)
+			nodeName := networkConfig.NodeConfigs[0].Name
+			node, err := net.GetNode(nodeName)
+			assert.NoError(err)
+
+			assert.Eventually(func() bool {
+				status := node.GetProcessStatus()
+				return status.Crashes == uint32(tt.crashes) && !status.Restarting && status.Running == tt.expectedRunning
+			}, defaultHealthyTimeout, 10*time.Millisecond)
+			status := node.GetProcessStatus()
+			assert.EqualValues(tt.expectedRestarts, status.Restarts)
+			assert.EqualValues(-1, status.ExitCode)
+			assert.False(status.ExitTime.IsZero())
+
+			err = awaitNetworkHealthy(net, defaultHealthyTimeout)
+			if tt.expectedRunning {
+				assert.NoError(err)
+			} else {
+				assert.ErrorIs(err, network.ErrNodeExited)
+			}
+			// removing a dead node doesn't fail
+			assert.NoError(net.RemoveNode(nodeName))
+			assert.EqualValues(uint32(tt.crashes), node.GetProcessStatus().Crashes)
+		})
+	}
+}
+
+// TestStopGracePeriod checks that a node that doesn't exit
+// once sent a SIGTERM is sent a SIGKILL after the grace period
+func TestStopGracePeriod(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	networkConfig := testNetworkConfig(t)
+	networkConfig.NodeConfigs = networkConfig.NodeConfigs[:1]
+	networkConfig.StopGracePeriod = 100 * time.Millisecond
+	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestHangingProcessCreator{}, "", "", nil)
+	assert.NoError(err)
+	nodeName := networkConfig.NodeConfigs[0].Name
+	node, err := net.GetNode(nodeName)
+	assert.NoError(err)
+	process := node.(*localNode).process.(*mocks.NodeProcess)
+
+	start := time.Now()
+	err = net.RemoveNode(nodeName)
+	assert.Error(err)
+	assert.Contains(err.Error(), "signal: killed")
+	assert.GreaterOrEqual(time.Since(start), networkConfig.StopGracePeriod)
+	process.AssertNumberOfCalls(t, "Stop", 1)
+	process.AssertNumberOfCalls(t, "Kill", 1)
+	assert.False(node.GetProcessStatus().Running)
+
+	networkConfig.StopGracePeriod = -time.Second
+	assert.Error(networkConfig.Validate())
+}
+
+// TestStopTimeout checks that a network whose nodes aren't stopped within
+// the timeout of Stop is stopped anyway, and that its health checks return
+func TestStopTimeout(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	networkConfig := testNetworkConfig(t)
+	networkConfig.NodeConfigs = networkConfig.NodeConfigs[:1]
+	networkConfig.StopGracePeriod = time.Second
+	newAPIClientF := func(ipAddr string, port uint16) api.Client {
+		client := newMockAPIUnhealthy(ipAddr, port).(*apimocks.Client)
+		ethClient := &apimocks.EthClient{}
+		ethClient.On("Close").Return()
+		client.On("CChainEthAPI").Return(ethClient)
+		return client
+	}
+	net, err := newNetwork(logging.NoLog{}, networkConfig, newAPIClientF, &localTestHangingProcessCreator{}, "", "", nil)
+	assert.NoError(err)
+	healthyCh := net.Healthy(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(net.Stop(ctx), context.DeadlineExceeded)
+	select {
+	case err := <-healthyCh:
+		assert.ErrorIs(err, network.ErrStopped)
+	case <-time.After(5 * time.Second):
+		assert.Fail("health check not stopped")
+	}
+	assert.ErrorIs(net.Stop(context.Background()), network.ErrStopped)
+}
+
+// TestParallelNodeStartsAndStops checks that the beacons are started first,
+// one by one, then the other nodes at once with bounded parallelism,
+// and that all the nodes are stopped at once
+func TestParallelNodeStartsAndStops(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	numNodes, numBeacons := 2*maxParallelNodeStarts, 2
+	networkConfig := testNetworkConfig(t)
+	// The other nodes share the identity of the last one, on allocated ports
+	refNodeConfig := networkConfig.NodeConfigs[numBeacons]
+	configFile, err := removeConfigFileKeys(refNodeConfig.ConfigFile, config.HTTPPortKey, config.StakingPortKey)
+	assert.NoError(err)
+	networkConfig.NodeConfigs = networkConfig.NodeConfigs[:numBeacons]
+	for i := numBeacons; i < numNodes; i++ {
+		nodeConfig := refNodeConfig
+		nodeConfig.Name = fmt.Sprintf("node%d", i)
+		nodeConfig.IsBeacon = false
+		nodeConfig.ConfigFile = configFile
+		networkConfig.NodeConfigs = append(networkConfig.NodeConfigs, nodeConfig)
+	}
+	delay := 200 * time.Millisecond
+	npc := &localTestSlowProcessCreator{delay: delay}
+
+	start := time.Now()
+	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, npc, "", "", nil)
+	assert.NoError(err)
+	assert.Less(time.Since(start), time.Duration(numNodes)*delay/2)
+	assert.Equal([]string{"node0", "node1"}, npc.started[:numBeacons])
+	assert.Len(npc.started, numNodes)
+	assert.Greater(npc.maxStarting, 1)
+	assert.LessOrEqual(npc.maxStarting, maxParallelNodeStarts)
+	names, err := net.GetNodeNames()
+	assert.NoError(err)
+	assert.Len(names, numNodes)
+
+	start = time.Now()
+	assert.NoError(net.Stop(context.Background()))
+	assert.Less(time.Since(start), time.Duration(numNodes)*delay/2)
+	_, err = net.GetNodeNames()
+	assert.ErrorIs(err, network.ErrStopped)
+}
+
+// getBootstrapIDsArg returns the bootstrap IDs [n] was started with
+func getBootstrapIDsArg(n node.Node) string {
+	return getArg(n, config.BootstrapIDsKey)
+}
+
+// getArg returns the value of flag [key] of the process of [n]
+func getArg(n node.Node, key string) string {
+	prefix := fmt.Sprintf("--%s=", key)
+	for _, arg := range n.(*localNode).args {
+		if strings.HasPrefix(arg, prefix) {
+			return strings.TrimPrefix(arg, prefix)
+		}
+	}
+	return ""
+}
+
+// TestBeacons checks that nodes bootstrap from the beacons, promoted
+// and demoted at runtime, or from their bootstrap nodes if given
+func TestBeacons(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	networkConfig := testNetworkConfig(t)
+	nodeConfigs := networkConfig.NodeConfigs
+	networkConfig.NodeConfigs = []node.Config{nodeConfigs[0], nodeConfigs[1]}
+	networkConfig.NodeConfigs[1].IsBeacon = false
+	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
+	assert.NoError(err)
+	node0, err := net.GetNode("node0")
+	assert.NoError(err)
+	node1, err := net.GetNode("node1")
+	assert.NoError(err)
+	id0, id1 := node0.GetNodeID().String(), node1.GetNodeID().String()
+	assert.Contains(getBootstrapIDsArg(node1), id0)
+
+	// The bootstrap nodes not in the network are skipped
+	nodeConfig := nodeConfigs[2]
+	nodeConfig.BootstrapNodes = []string{"node1", "node9"}
+	node2, err := net.AddNode(nodeConfig)
+	assert.NoError(err)
+	assert.Contains(getBootstrapIDsArg(node2), id1)
+	assert.NotContains(getBootstrapIDsArg(node2), id0)
+	assert.NoError(net.RemoveNode("node2"))
+
+	assert.NoError(net.SetBeacon("node1", true))
+	assert.NoError(net.SetBeacon("node0", false))
+	assert.NoError(net.SetBeacon("node0", false))
+	assert.True(node1.GetConfig().IsBeacon)
+	assert.False(node0.GetConfig().IsBeacon)
+	node2, err = net.AddNode(nodeConfigs[2])
+	assert.NoError(err)
+	assert.Contains(getBootstrapIDsArg(node2), id1)
+	assert.NotContains(getBootstrapIDsArg(node2), id0)
+	// The running nodes keep their beacons
+	assert.Contains(getBootstrapIDsArg(node1), id0)
+
+	assert.Error(net.SetBeacon("node9", true))
+	assert.NoError(net.Stop(context.Background()))
+	assert.ErrorIs(net.SetBeacon("node1", false), network.ErrStopped)
+}
+
+// TestDistinctNodeIPs checks that each node gets its own loopback IP,
+// kept when it's removed and added again
+func TestDistinctNodeIPs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	networkConfig := testNetworkConfig(t)
+	networkConfig.DistinctNodeIPs = true
+	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
+	assert.NoError(err)
+	nodes, err := net.GetAllNodes()
+	assert.NoError(err)
+	ips := map[string]bool{}
+	for _, n := range nodes {
+		ip := n.GetURL()
+		assert.Regexp(`^127\.0\.1\.[1-3]$`, ip)
+		assert.Equal(ip, getArg(n, config.PublicIPKey))
+		assert.Equal(ip, getArg(n, config.HTTPHostKey))
+		ips[ip] = true
+	}
+	assert.Len(ips, len(nodes))
+
+	node0 := nodes["node0"]
+	node2 := nodes["node2"]
+	assert.Contains(getArg(node2, config.BootstrapIPsKey), fmt.Sprintf("%s:%d", node0.GetURL(), node0.GetP2PPort()))
+	assert.NoError(net.RemoveNode("node2"))
+	readded, err := net.AddNode(node2.GetConfig())
+	assert.NoError(err)
+	assert.Equal(node2.GetURL(), readded.GetURL())
+
+	assert.NoError(net.Stop(context.Background()))
+}
+
+func TestNodeIP(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	assert.Equal("127.0.1.1", nodeIP(0).String())
+	assert.Equal("127.0.1.254", nodeIP(253).String())
+	assert.Equal("127.0.2.1", nodeIP(254).String())
+	assert.Equal("127.0.255.254", nodeIP(maxNodeIPs-1).String())
+}
+
+func TestNextNodeBatch(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	nodeConfigs := []node.Config{
+		{Name: "a", BootstrapNodes: []string{"c"}},
+		{Name: "b", BootstrapNodes: []string{"beacon"}},
+		{Name: "c"},
+		{Name: "d", BootstrapNodes: []string{"a", "b"}},
+	}
+	batch, rest, err := nextNodeBatch(nodeConfigs)
+	assert.NoError(err)
+	assert.Equal([]string{"b", "c"}, nodeNamesOf(batch))
+	batch, rest, err = nextNodeBatch(rest)
+	assert.NoError(err)
+	assert.Equal([]string{"a"}, nodeNamesOf(batch))
+	batch, rest, err = nextNodeBatch(rest)
+	assert.NoError(err)
+	assert.Equal([]string{"d"}, nodeNamesOf(batch))
+	assert.Empty(rest)
+
+	_, _, err = nextNodeBatch([]node.Config{
+		{Name: "a", BootstrapNodes: []string{"b"}},
+		{Name: "b", BootstrapNodes: []string{"a"}},
+	})
+	assert.Error(err)
+}
+
+func TestNewDefaultConfigNNodesAndBeacons(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	networkConfig, err := NewDefaultConfigNNodesAndBeacons("pepito", 3, 1)
+	assert.NoError(err)
+	assert.Len(networkConfig.NodeConfigs, 3)
+	for i, nodeConfig := range networkConfig.NodeConfigs {
+		assert.Equal(i == 0, nodeConfig.IsBeacon)
+	}
+	assert.NoError(networkConfig.Validate())
+
+	_, err = NewDefaultConfigNNodesAndBeacons("pepito", 3, 0)
+	assert.Error(err)
+	_, err = NewDefaultConfigNNodesAndBeacons("pepito", 3, 4)
+	assert.Error(err)
+}
+
+func TestGetRestartBackoff(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	assert.EqualValues(defaultRestartBackoff, getRestartBackoff(0, 0))
+	assert.EqualValues(2*time.Second, getRestartBackoff(time.Second, 1))
+	assert.EqualValues(8*time.Second, getRestartBackoff(time.Second, 3))
+	assert.EqualValues(maxRestartBackoff, getRestartBackoff(time.Second, 100))
+}
+
+// TestNetworkEvents checks that the events of a
+// node's lifecycle are published in order
+func TestNetworkEvents(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	networkConfig := testNetworkConfig(t)
+	networkConfig.NodeConfigs = networkConfig.NodeConfigs[:1]
+	nodeName := networkConfig.NodeConfigs[0].Name
+	events := &network.EventBus{}
+	eventsCh, unsubscribe := events.Subscribe()
+	defer unsubscribe()
+	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", events)
+	assert.NoError(err)
+	assert.NoError(awaitNetworkHealthy(net, defaultHealthyTimeout))
+	assert.NoError(net.RemoveNode(nodeName))
+
+	expectedTypes := []network.EventType{
+		network.EventNodeAdded,
+		network.EventNodeStarted,
+		network.EventNodeHealthy,
+		network.EventNodeExited,
+		network.EventNodeRemoved,
+	}
+	for _, expectedType := range expectedTypes {
+		select {
+		case event := <-eventsCh:
+			assert.EqualValues(expectedType, event.Type)
+			assert.EqualValues(nodeName, event.NodeName)
+			assert.False(event.Time.IsZero())
+		case <-time.After(defaultHealthyTimeout):
+			assert.FailNow("missing event", expectedType)
+		}
+	}
+}
+
+// Returns an API client whose Health API reports
+// a failing "bootstrapped" check and a passing "network" check
+func newMockAPIFailingCheck(ipAddr string, port uint16) api.Client {
+	checkErr := "not bootstrapped yet"
+	healthReply := &health.APIHealthReply{
+		Healthy: false,
+		Checks: map[string]health.Result{
+			"bootstrapped": {Error: &checkErr, ContiguousFailures: 3},
+			"network":      {Details: map[string]interface{}{"connectedPeers": 4}},
+		},
+	}
+	healthClient := &healthmocks.Client{}
+	healthClient.On("Health", mock.Anything).Return(healthReply, nil)
+	client := &apimocks.Client{}
+	client.On("HealthAPI").Return(healthClient)
+	return client
+}
+
+// TestNodeHealth checks the health reports of single nodes
+func TestNodeHealth(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	networkConfig := testNetworkConfig(t)
+	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
+	assert.NoError(err)
+	nodeNames := []string{networkConfig.NodeConfigs[0].Name, networkConfig.NodeConfigs[1].Name}
+
+	// not checked yet
+	reports, err := net.GetHealthReports()
+	assert.NoError(err)
+	assert.Len(reports, len(networkConfig.NodeConfigs))
+	assert.True(reports[nodeNames[0]].Time.IsZero())
+
+	assert.NoError(<-net.WaitForNodes(context.Background(), nodeNames...))
+	reports, err = net.GetHealthReports()
+	assert.NoError(err)
+	for _, nodeName := range nodeNames {
+		assert.True(reports[nodeName].Healthy)
+		assert.False(reports[nodeName].Time.IsZero())
+	}
+	assert.Error(<-net.WaitForNodes(context.Background(), "pepito"))
+
+	report, err := net.NodeHealth(context.Background(), nodeNames[0])
+	assert.NoError(err)
+	assert.True(report.Healthy)
+	assert.EqualValues(nodeNames[0], report.NodeName)
+	_, err = net.NodeHealth(context.Background(), "pepito")
+	assert.Error(err)
+
+	// a paused node isn't queried
+	assert.NoError(net.PauseNode(nodeNames[0]))
+	report, err = net.NodeHealth(context.Background(), nodeNames[0])
+	assert.NoError(err)
+	assert.False(report.Healthy)
+	assert.Contains(report.Error, network.ErrNodePaused.Error())
+	ctx, cancel := context.WithTimeout(context.Background(), defaultHealthyTimeout)
+	defer cancel()
+	assert.ErrorIs(<-net.WaitForNodes(ctx, nodeNames...), network.ErrNodePaused)
+	assert.NoError(<-net.WaitForNodes(ctx, nodeNames[1]))
+
+	assert.NoError(net.Stop(context.Background()))
+	_, err = net.NodeHealth(context.Background(), nodeNames[0])
+	assert.ErrorIs(err, network.ErrStopped)
+	assert.ErrorIs(<-net.WaitForNodes(context.Background(), nodeNames...), network.ErrStopped)
+}
+
+// TestNodeHealthChecks checks that the failing
+// health checks of a node are reported
+func TestNodeHealthChecks(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	networkConfig := testNetworkConfig(t)
+	net, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPIFailingCheck, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
+	assert.NoError(err)
+	nodeName := networkConfig.NodeConfigs[0].Name
+
+	report, err := net.NodeHealth(context.Background(), nodeName)
+	assert.NoError(err)
+	assert.False(report.Healthy)
+	assert.Empty(report.Error)
+	assert.Equal([]string{"bootstrapped"}, report.FailingChecks())
+	assert.EqualValues("not bootstrapped yet", report.Checks["bootstrapped"].Error)
+	assert.EqualValues(3, report.Checks["bootstrapped"].ContiguousFailures)
+	assert.True(report.Checks["network"].Healthy)
+	assert.JSONEq(`{"connectedPeers":4}`, report.Checks["network"].Message)
+
+	reports, err := net.GetHealthReports()
+	assert.NoError(err)
+	assert.Equal([]string{"bootstrapped"}, reports[nodeName].FailingChecks())
+}
+
+// TestPartition checks that the P2P connections between nodes
+// of different groups are closed, and refused until the partition is healed
+func TestPartition(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	networkConfig := testNetworkConfig(t)
+	networkConfig.NodeConfigs = networkConfig.NodeConfigs[:2]
+	networkConfig.NodeConfigs[1].IsBeacon = false
+	nw, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
+	assert.NoError(err)
+	err = nw.Partition([][]string{{"node0"}, {"node1"}})
+	assert.ErrorIs(err, network.ErrNoP2PProxy)
+	assert.NoError(nw.Stop(context.Background()))
+
+	networkConfig.P2PProxy = true
+	nw, err = newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
+	assert.NoError(err)
+	defer func() {
+		_ = nw.Stop(context.Background())
+	}()
+	ln := nw.(*localNetwork)
+	node0 := ln.nodes["node0"]
+	node1 := ln.nodes["node1"]
+	assert.EqualValues(0, node1.config.Flags[config.NetworkPeerListPeersGossipSizeKey])
+	assert.NotContains(node1.config.Flags, config.NetworkPingTimeoutKey)
+
+	// node1 reaches node0 through a link of the proxy of node0
+	link, ok := node0.proxy.getLinks()["node1"]
+	assert.True(ok)
+	assert.Contains(node1.args, fmt.Sprintf("--%s=[::1]:%d", config.BootstrapIPsKey, link.port))
+	assert.Empty(node1.proxy.getLinks())
+
+	// Echo server in place of node0
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", node0.p2pPort))
+	assert.NoError(err)
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+	// The link only listens on loopback
+	_, err = net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", link.port))
+	assert.Error(err)
+	conn, err := net.Dial("tcp", fmt.Sprintf("[::1]:%d", link.port))
+	assert.NoError(err)
+	defer conn.Close()
+	echo := func(msg string) (string, error) {
+		if _, err := conn.Write([]byte(msg)); err != nil {
+			return "", err
+		}
+		buf := make([]byte, len(msg))
+		_ = conn.SetReadDeadline(time.Now().Add(500 * time.Millisecond))
+		n, err := io.ReadFull(conn, buf)
+		return string(buf[:n]), err
+	}
+	reply, err := echo("ping")
+	assert.NoError(err)
+	assert.Equal("ping", reply)
+
+	// Invalid partitions
+	assert.Error(nw.Partition([][]string{{"node0"}, {"node2"}}))
+	assert.Error(nw.Partition([][]string{{"node0"}, {"node0", "node1"}}))
+
+	// The connection is closed, and new ones are refused until healed
+	assert.NoError(nw.Partition([][]string{{"node0"}, {"node1"}}))
+	_ = conn.SetReadDeadline(time.Now().Add(500 * time.Millisecond))
+	_, err = conn.Read(make([]byte, 1))
+	assert.ErrorIs(err, io.EOF)
+	conn, err = net.Dial("tcp", fmt.Sprintf("[::1]:%d", link.port))
+	assert.NoError(err)
+	defer conn.Close()
+	_, err = echo("pong")
+	assert.Error(err)
+	assert.NoError(nw.Heal())
+	conn, err = net.Dial("tcp", fmt.Sprintf("[::1]:%d", link.port))
+	assert.NoError(err)
+	defer conn.Close()
+	reply, err = echo("ping")
+	assert.NoError(err)
+	assert.Equal("ping", reply)
+}
+
+// TestProxyBypasses checks that the connections
+// between nodes not going through a proxy link are found
+func TestProxyBypasses(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	networkConfig := testNetworkConfig(t)
+	networkConfig.NodeConfigs = networkConfig.NodeConfigs[:2]
+	networkConfig.NodeConfigs[1].IsBeacon = false
+	networkConfig.P2PProxy = true
+	nw, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
+	assert.NoError(err)
+	defer func() {
+		_ = nw.Stop(context.Background())
+	}()
+	ln := nw.(*localNetwork)
+	node0 := ln.nodes["node0"]
+	node1 := ln.nodes["node1"]
+	link, ok := node0.proxy.getLinks()["node1"]
+	assert.True(ok)
+
+	// Echo server in place of node0
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", node0.p2pPort))
+	assert.NoError(err)
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+	conn, err := net.Dial("tcp", fmt.Sprintf("[::1]:%d", link.port))
+	assert.NoError(err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("ping"))
+	assert.NoError(err)
+	_, err = io.ReadFull(conn, make([]byte, 4))
+	assert.NoError(err)
+
+	var targetAddr string
+	for addr := range link.addrs() {
+		if addr != conn.RemoteAddr().String() {
+			targetAddr = addr
+		}
+	}
+	assert.NotEmpty(targetAddr)
+	setPeers := func(node *localNode, peers ...info.Peer) {
+		infoClient := &infomocks.Client{}
+		infoClient.On("Peers", mock.Anything).Return(peers, nil)
+		ethClient := &apimocks.EthClient{}
+		ethClient.On("Close").Return()
+		client := &apimocks.Client{}
+		client.On("InfoAPI").Return(infoClient)
+		client.On("CChainEthAPI").Return(ethClient)
+		node.client = client
+	}
+	newPeer := func(node *localNode, ip string) info.Peer {
+		peer := info.Peer{}
+		peer.ID = node.nodeID.PrefixedString(constants.NodeIDPrefix)
+		peer.IP = ip
+		return peer
+	}
+
+	// node1 reaches node0 through the link, which reaches node0 from [targetAddr]
+	setPeers(node1, newPeer(node0, conn.RemoteAddr().String()), info.Peer{})
+	bypasses, err := ln.getProxyBypasses(node1)
+	assert.NoError(err)
+	assert.Empty(bypasses)
+	setPeers(node0, newPeer(node1, targetAddr))
+	bypasses, err = ln.getProxyBypasses(node0)
+	assert.NoError(err)
+	assert.Empty(bypasses)
+
+	// node0 reaches node1 directly
+	directAddr := fmt.Sprintf("127.0.0.1:%d", node1.p2pPort)
+	setPeers(node0, newPeer(node1, directAddr))
+	bypasses, err = ln.getProxyBypasses(node0)
+	assert.NoError(err)
+	assert.Equal(map[string]string{"node1": directAddr}, bypasses)
+}
+
+func TestLinkFaults(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	networkConfig := testNetworkConfig(t)
+	networkConfig.NodeConfigs = networkConfig.NodeConfigs[:2]
+	networkConfig.NodeConfigs[1].IsBeacon = false
+	nw, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
+	assert.NoError(err)
+	err = nw.SetNodeFaults("node0", network.LinkFaults{Delay: time.Second})
+	assert.ErrorIs(err, network.ErrNoP2PProxy)
+	assert.NoError(nw.Stop(context.Background()))
+
+	networkConfig.P2PProxy = true
+	nw, err = newNetwork(logging.NoLog{}, networkConfig, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil)
+	assert.NoError(err)
+	defer func() {
+		_ = nw.Stop(context.Background())
+	}()
+	node0 := nw.(*localNetwork).nodes["node0"]
+	link, ok := node0.proxy.getLinks()["node1"]
+	assert.True(ok)
+
+	// Echo server in place of node0
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", node0.p2pPort))
+	assert.NoError(err)
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+	conn, err := net.Dial("tcp", fmt.Sprintf("[::1]:%d", link.port))
+	assert.NoError(err)
+	defer conn.Close()
+	echo := func(msg string) (time.Duration, error) {
+		start := time.Now()
+		if _, err := conn.Write([]byte(msg)); err != nil {
+			return 0, err
+		}
+		buf := make([]byte, len(msg))
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		_, err := io.ReadFull(conn, buf)
+		return time.Since(start), err
+	}
+
+	// Invalid faults
+	assert.Error(nw.SetNodeFaults("node2", network.LinkFaults{}))
+	assert.Error(nw.SetNodeFaults("node0", network.LinkFaults{DropProbability: 2}))
+	assert.Error(nw.SetLinkFaults("node0", "node0", network.LinkFaults{}))
+
+	// The delays of both nodes add up, in each direction
+	assert.NoError(nw.SetNodeFaults("node0", network.LinkFaults{Delay: 100 * time.Millisecond}))
+	assert.NoError(nw.SetNodeFaults("node1", network.LinkFaults{Delay: 50 * time.Millisecond}))
+	assert.Equal(network.LinkFaults{Delay: 150 * time.Millisecond}, link.getFaults())
+	rtt, err := echo("ping")
+	assert.NoError(err)
+	assert.GreaterOrEqual(rtt, 300*time.Millisecond)
+
+	// Link faults replace node faults
+	assert.NoError(nw.SetLinkFaults("node1", "node0", network.LinkFaults{Bandwidth: 1000}))
+	assert.Equal(network.LinkFaults{Bandwidth: 1000}, link.getFaults())
+	rtt, err = echo(string(make([]byte, 500)))
+	assert.NoError(err)
+	assert.GreaterOrEqual(rtt, 500*time.Millisecond)
+	assert.NoError(nw.SetLinkFaults("node0", "node1", network.LinkFaults{}))
+	assert.Equal(network.LinkFaults{Delay: 150 * time.Millisecond}, link.getFaults())
+	rtt, err = echo("ping")
+	assert.NoError(err)
+	assert.GreaterOrEqual(rtt, 300*time.Millisecond)
+
+	// Drops refuse new connections
+	assert.NoError(nw.SetNodeFaults("node0", network.LinkFaults{DropProbability: 1}))
+	conn, err = net.Dial("tcp", fmt.Sprintf("[::1]:%d", link.port))
+	assert.NoError(err)
+	defer conn.Close()
+	_, err = echo("ping")
+	assert.Error(err)
+
+	assert.NoError(nw.SetNodeFaults("node0", network.LinkFaults{}))
+	assert.NoError(nw.SetNodeFaults("node1", network.LinkFaults{}))
+	assert.True(link.getFaults().IsZero())
+}
+
+func TestRemoteNodeProcess(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	networkConfig := testNetworkConfig(t)
+	apiHosts := map[string]struct{}{}
+	var apiHostsLock sync.Mutex
+	newAPIClientF := func(ipAddr string, port uint16) api.Client {
+		apiHostsLock.Lock()
+		apiHosts[ipAddr] = struct{}{}
+		apiHostsLock.Unlock()
+		return newMockAPISuccessful(ipAddr, port)
+	}
+	nw, err := newNetwork(logging.NoLog{}, networkConfig, newAPIClientF, &localTestRemoteProcessCreator{host: "10.0.0.1"}, "", "", nil)
+	assert.NoError(err)
+	defer func() {
+		_ = nw.Stop(context.Background())
+	}()
+
+	// Nodes are reached at their host, by the runner and by their peers
+	ln := nw.(*localNetwork)
+	for _, node := range ln.nodes {
+		assert.Equal("10.0.0.1", node.GetURL())
+	}
+	assert.Equal(map[string]struct{}{"10.0.0.1": {}}, apiHosts)
+	node0 := ln.nodes["node0"]
+	assert.Contains(ln.nodes["node1"].args, fmt.Sprintf("--%s=10.0.0.1:%d", config.BootstrapIPsKey, node0.p2pPort))
+}
+
+func TestGetExitStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	exitCode, exitSignal := getExitStatus(nil)
+	assert.Equal(0, exitCode)
+	assert.Empty(exitSignal)
+	exitCode, exitSignal = getExitStatus(errors.New("unknown"))
+	assert.Equal(-1, exitCode)
+	assert.Empty(exitSignal)
+	exitCode, exitSignal = getExitStatus(fmt.Errorf("wrapped: %w", &ExitError{ExitCode: 3}))
+	assert.Equal(3, exitCode)
+	assert.Empty(exitSignal)
+	exitCode, exitSignal = getExitStatus(&ExitError{ExitCode: -1, ExitSignal: "killed"})
+	assert.Equal(-1, exitCode)
+	assert.Equal("killed", exitSignal)
+}
+
+func TestRollingUpgrade(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	networkConfig := testNetworkConfig(t)
+	nw, err := newNetwork(logging.NoLog{}, networkConfig, newMockAPIStarting, &localTestUpgradeProcessCreator{badBinaryPath: "bad"}, "", "", nil)
+	assert.NoError(err)
+	defer func() {
+		_ = nw.Stop(context.Background())
+	}()
+	ln := nw.(*localNetwork)
+	getNodes := func() map[string]*localNode {
+		ln.lock.RLock()
+		defer ln.lock.RUnlock()
+
+		nodes := make(map[string]*localNode, len(ln.nodes))
+		for name, node := range ln.nodes {
+			nodes[name] = node
+		}
+		return nodes
+	}
+	nodes := getNodes()
+	previousBinaryPath := nodes["node0"].config.BinaryPath
+
+	// Invalid options
+	assert.Error(nw.RollingUpgrade(context.Background(), "v2", network.UpgradeOptions{BatchSize: -1}))
+	assert.Error(nw.RollingUpgrade(context.Background(), "v2", network.UpgradeOptions{NodeNames: []string{"node0", "node0"}}))
+	assert.Error(nw.RollingUpgrade(context.Background(), "v2", network.UpgradeOptions{NodeNames: []string{"node3"}}))
+
+	// Nodes keep their ports and staking keys
+	opts := network.UpgradeOptions{
+		BatchSize:     2,
+		HealthTimeout: 10 * time.Second,
+	}
+	assert.NoError(nw.RollingUpgrade(context.Background(), "v2", opts))
+	for name, node := range getNodes() {
+		assert.Equal("v2", node.config.BinaryPath)
+		assert.Equal(nodes[name].apiPort, node.apiPort)
+		assert.Equal(nodes[name].p2pPort, node.p2pPort)
+		assert.Equal(nodes[name].nodeID, node.nodeID)
+		assert.Contains(node.args, fmt.Sprintf("--%s=%s", config.DBPathKey, nodes[name].dbDir))
+	}
+
+	// A failed upgrade is aborted
+	opts = network.UpgradeOptions{
+		NodeNames:     []string{"node2", "node1", "node0"},
+		HealthTimeout: 10 * time.Second,
+	}
+	err = nw.RollingUpgrade(context.Background(), "bad", opts)
+	var upgradeErr *network.UpgradeError
+	assert.ErrorAs(err, &upgradeErr)
+	assert.Equal([]string{"node2"}, upgradeErr.Upgraded)
+	assert.Equal([]string{"node2"}, upgradeErr.FailedBatch)
+	assert.False(upgradeErr.RolledBack)
+	assert.ErrorIs(err, network.ErrNodeExited)
+	nodes = getNodes()
+	assert.Equal("bad", nodes["node2"].config.BinaryPath)
+	assert.Equal("v2", nodes["node1"].config.BinaryPath)
+
+	// or rolled back
+	assert.NoError(nw.RollingUpgrade(context.Background(), previousBinaryPath, network.UpgradeOptions{}))
+	opts.Rollback = true
+	opts.BatchSize = 2
+	err = nw.RollingUpgrade(context.Background(), "bad", opts)
+	assert.ErrorAs(err, &upgradeErr)
+	assert.Equal([]string{"node2", "node1"}, upgradeErr.Upgraded)
+	assert.True(upgradeErr.RolledBack)
+	assert.NoError(upgradeErr.RollbackErr)
+	for _, node := range getNodes() {
+		assert.Equal(previousBinaryPath, node.config.BinaryPath)
+	}
+	assert.NoError(<-nw.Healthy(context.Background()))
+}
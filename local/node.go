@@ -3,13 +3,18 @@ package local
 import (
 	"context"
 	"crypto"
+	"errors"
 	"fmt"
+	"io"
 	"net"
+	"os"
 	"os/exec"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/ava-labs/avalanche-network-runner/api"
+	"github.com/ava-labs/avalanche-network-runner/network"
 	"github.com/ava-labs/avalanche-network-runner/network/node"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
@@ -29,9 +34,13 @@ import (
 var (
 	_ node.Node   = (*localNode)(nil)
 	_ NodeProcess = (*nodeProcessImpl)(nil)
+	_ pidProcess  = (*nodeProcessImpl)(nil)
 	_ getConnFunc = defaultGetConnFunc
 )
 
+// Host of the nodes that run on this host
+const localHost = "127.0.0.1"
+
 type getConnFunc func(context.Context, node.Node) (net.Conn, error)
 
 // NodeProcess as an interface so we can mock running
@@ -41,24 +50,112 @@ type NodeProcess interface {
 	Start() error
 	// Send a SIGTERM to this process
 	Stop() error
+	// Send a SIGKILL to this process
+	Kill() error
 	// Returns when the process finishes exiting
 	Wait() error
+	// Send a SIGSTOP to this process
+	Pause() error
+	// Send a SIGCONT to this process
+	Resume() error
+}
+
+// RemoteNodeProcess is a NodeProcess running on another host.
+// The network reaches the node at that host instead of localhost.
+type RemoteNodeProcess interface {
+	NodeProcess
+	// Returns the host the process runs on
+	GetHost() string
+}
+
+// pidProcess is a NodeProcess running on this host,
+// whose resource usage can be read from /proc
+type pidProcess interface {
+	// Returns the PID of the process
+	getPID() int
+}
+
+// ExitError is returned by Wait when a NodeProcess that isn't
+// a child of this process, and so has no *exec.ExitError, fails
+type ExitError struct {
+	// Exit code of the process, or -1 if it was killed by a signal
+	ExitCode int
+	// Name of the signal that killed the process, if any
+	ExitSignal string
+}
+
+func (e *ExitError) Error() string {
+	if e.ExitSignal != "" {
+		return fmt.Sprintf("signal: %s", e.ExitSignal)
+	}
+	return fmt.Sprintf("exit status %d", e.ExitCode)
 }
 
 type nodeProcessImpl struct {
 	cmd *exec.Cmd
+	// Closed once the process exits, so that readers
+	// of the redirected output see EOF
+	closeOnWait []io.Closer
 }
 
 func (p *nodeProcessImpl) Start() error {
-	return p.cmd.Start()
+	if err := p.cmd.Start(); err != nil {
+		// Wait won't be called
+		p.closeOutput()
+		return err
+	}
+	return nil
 }
 
 func (p *nodeProcessImpl) Wait() error {
-	return p.cmd.Wait()
+	err := p.cmd.Wait()
+	p.closeOutput()
+	return err
+}
+
+func (p *nodeProcessImpl) closeOutput() {
+	for _, closer := range p.closeOnWait {
+		_ = closer.Close()
+	}
 }
 
 func (p *nodeProcessImpl) Stop() error {
-	return p.cmd.Process.Signal(syscall.SIGTERM)
+	return p.signal(syscall.SIGTERM)
+}
+
+func (p *nodeProcessImpl) Kill() error {
+	return p.signal(syscall.SIGKILL)
+}
+
+func (p *nodeProcessImpl) Pause() error {
+	return p.signal(syscall.SIGSTOP)
+}
+
+func (p *nodeProcessImpl) Resume() error {
+	return p.signal(syscall.SIGCONT)
+}
+
+// signal sends [sig] to the process group of the process, which
+// includes the processes it started, e.g. the ones of VM plugins.
+// Returns os.ErrProcessDone if there's no process left in the group.
+func (p *nodeProcessImpl) signal(sig syscall.Signal) error {
+	return signalProcessGroup(p.cmd.Process.Pid, sig)
+}
+
+// signalProcessGroup sends [sig] to the process group [pgid].
+// Returns os.ErrProcessDone if there's no process left in the group.
+func signalProcessGroup(pgid int, sig syscall.Signal) error {
+	if err := syscall.Kill(-pgid, sig); err != nil {
+		if errors.Is(err, syscall.ESRCH) {
+			return os.ErrProcessDone
+		}
+		return err
+	}
+	return nil
+}
+
+func (p *nodeProcessImpl) getPID() int {
+	return p.cmd.Process.Pid
 }
 
 // Gives access to basic node info, and to most avalanchego apis
@@ -73,13 +170,58 @@ type localNode struct {
 	// Allows user to make API calls to this node.
 	client api.Client
 	// The process running this node.
+	// Replaced when the node supervisor restarts it.
+	// Guarded by [lock].
 	process NodeProcess
+	// The args the process was started with
+	args []string
+	// The host the node runs on
+	host string
+	// The IP the other nodes reach the node at
+	ip net.IP
 	// The API port
 	apiPort uint16
 	// The P2P (staking) port
 	p2pPort uint16
+	// Ports reserved for this node, released when it's removed
+	reservedPorts []uint16
+	// Ports allocated to this node by the network,
+	// which are replaced if another process binds them
+	allocatedPorts []uint16
+	// Proxy the other nodes reach this node through.
+	// Nil if the network doesn't proxy P2P traffic.
+	proxy *nodeProxy
+	// Number of times this node was restarted on fresh ports.
+	// Guarded by the network lock.
+	portRetries int
 	// Returns a connection to this node
 	getConnFunc getConnFunc
+	// The db dir of the node
+	dbDir string
+	// The logs dir of the node
+	logsDir string
+	// The node config.
+	// Its IsBeacon is changed under [lock] and the network lock.
+	config node.Config
+	// Guards the fields below, which change while the node runs
+	lock sync.RWMutex
+	// True if the process of this node is paused
+	paused bool
+	// State of the process, kept by the node supervisor
+	processStatus node.ProcessStatus
+	// True once the network asked this node to stop.
+	// The supervisor doesn't restart a stopping node.
+	stopping bool
+	// Error returned by the last wait on the process
+	exitErr error
+	// Closed when the network asks this node to stop
+	stopCh chan struct{}
+	// Closed when the node supervisor returns
+	supervisorDoneCh chan struct{}
+	// Last health reported by the node
+	healthReport network.HealthReport
+	// Recent samples of the resources used by the node
+	resourceUsage resourceHistory
 }
 
 func defaultGetConnFunc(ctx context.Context, node node.Node) (net.Conn, error) {
@@ -181,7 +323,7 @@ func (node *localNode) GetAPIClient() api.Client {
 
 // See node.Node
 func (node *localNode) GetURL() string {
-	return "127.0.0.1"
+	return node.host
 }
 
 // See node.Node
@@ -193,3 +335,147 @@ func (node *localNode) GetP2PPort() uint16 {
 func (node *localNode) GetAPIPort() uint16 {
 	return node.apiPort
 }
+
+// See node.Node
+func (node *localNode) GetDbDir() string {
+	return node.dbDir
+}
+
+// See node.Node
+func (node *localNode) GetLogsDir() string {
+	return node.logsDir
+}
+
+// See node.Node
+func (node *localNode) GetConfig() node.Config {
+	node.lock.RLock()
+	defer node.lock.RUnlock()
+
+	return node.config
+}
+
+// See node.Node
+func (node *localNode) GetPaused() bool {
+	node.lock.RLock()
+	defer node.lock.RUnlock()
+
+	return node.paused
+}
+
+func (node *localNode) setPaused(paused bool) {
+	node.lock.Lock()
+	defer node.lock.Unlock()
+
+	node.paused = paused
+}
+
+// See node.Node
+func (node *localNode) GetProcessStatus() node.ProcessStatus {
+	node.lock.RLock()
+	defer node.lock.RUnlock()
+
+	return node.processStatus
+}
+
+// See node.Node
+func (node *localNode) GetResourceUsage() []node.ResourceUsage {
+	node.lock.RLock()
+	defer node.lock.RUnlock()
+
+	return node.resourceUsage.samples()
+}
+
+func (node *localNode) getProcess() NodeProcess {
+	node.lock.RLock()
+	defer node.lock.RUnlock()
+
+	return node.process
+}
+
+// Returns the error of the last exit of the process
+func (node *localNode) getExitErr() error {
+	node.lock.RLock()
+	defer node.lock.RUnlock()
+
+	return node.exitErr
+}
+
+// stop sends a SIGTERM to the process of this node, if it's running,
+// and tells the node supervisor not to restart it.
+// A paused process is resumed first, as it doesn't handle
+// SIGTERM until then.
+func (node *localNode) stop() error {
+	node.lock.Lock()
+	defer node.lock.Unlock()
+
+	if node.stopping {
+		return nil
+	}
+	node.stopping = true
+	close(node.stopCh)
+	if !node.processStatus.Running {
+		// Already recorded as a crash
+		node.exitErr = nil
+		return nil
+	}
+	// The process may have exited before the supervisor noticed,
+	// so os.ErrProcessDone is ignored
+	if node.paused {
+		if err := node.process.Resume(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			return fmt.Errorf("error sending SIGCONT: %w", err)
+		}
+		node.paused = false
+	}
+	if err := node.process.Stop(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return fmt.Errorf("error sending SIGTERM: %w", err)
+	}
+	return nil
+}
+
+// kill sends a SIGKILL to the process of this node, if it's
+// running. Must be called after stop.
+func (node *localNode) kill() error {
+	node.lock.Lock()
+	defer node.lock.Unlock()
+
+	if !node.processStatus.Running {
+		return nil
+	}
+	if err := node.process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return fmt.Errorf("error sending SIGKILL: %w", err)
+	}
+	return nil
+}
+
+func (node *localNode) getHealthReport() network.HealthReport {
+	node.lock.RLock()
+	defer node.lock.RUnlock()
+
+	if node.healthReport.NodeName == "" {
+		// Not checked yet
+		return network.HealthReport{NodeName: node.name}
+	}
+	return node.healthReport
+}
+
+func (node *localNode) setHealthReport(report network.HealthReport) {
+	node.lock.Lock()
+	defer node.lock.Unlock()
+
+	node.healthReport = report
+}
+
+// resolveHost returns the IP of [host], which other nodes reach it at
+func resolveHost(host string) (net.IP, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		return ip, nil
+	}
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't resolve host %q: %w", host, err)
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("host %q has no IP", host)
+	}
+	return ips[0], nil
+}
@@ -0,0 +1,147 @@
+package local
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sync"
+	"time"
+)
+
+// Interval between the checks of an output log for new output
+const outputLogPollFreq = 100 * time.Millisecond
+
+// outputLog is a file written to by a node process. The process is given
+// the file itself, and not a pipe, so that it never blocks on its output,
+// and keeps running if the network runner exits.
+// The runner follows the file, copying to [redirect], if not nil, what the
+// process appends to it, and rotates it once it reaches [maxSize] bytes,
+// keeping [maxFiles] rotated files. As the process keeps the file open,
+// it's rotated by copying and truncating it, so the output written
+// meanwhile may be lost.
+type outputLog struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+	// Given to the process, which appends to it
+	file *os.File
+	// Reads what the process appended to the file
+	reader *os.File
+	// Offset in the file up to which it was followed
+	offset   int64
+	redirect io.Writer
+	// Closed when the log is closed
+	closedCh  chan struct{}
+	doneCh    chan struct{}
+	closeOnce sync.Once
+}
+
+// newOutputLog opens the file at [path] for appending, creating it if
+// needed, and follows what is appended to it from then on
+func newOutputLog(path string, maxSize int64, maxFiles int, redirect io.Writer) (*outputLog, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := os.Open(path)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	info, err := reader.Stat()
+	if err != nil {
+		_ = file.Close()
+		_ = reader.Close()
+		return nil, err
+	}
+	l := &outputLog{
+		path:     path,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+		file:     file,
+		reader:   reader,
+		offset:   info.Size(),
+		redirect: redirect,
+		closedCh: make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+	go l.follow()
+	return l, nil
+}
+
+// follow polls the file until the log is closed
+func (l *outputLog) follow() {
+	defer close(l.doneCh)
+
+	ticker := time.NewTicker(outputLogPollFreq)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			l.poll()
+		case <-l.closedCh:
+			// What the process wrote before exiting
+			l.poll()
+			return
+		}
+	}
+}
+
+// poll copies to [l.redirect] what was appended to the file since
+// the last poll, and rotates the file if it reached its max size
+func (l *outputLog) poll() {
+	info, err := l.reader.Stat()
+	if err != nil {
+		return
+	}
+	size := info.Size()
+	if size < l.offset {
+		// Truncated by another runner rotating it
+		l.offset = 0
+	}
+	if l.redirect != nil {
+		n, _ := io.Copy(l.redirect, io.NewSectionReader(l.reader, l.offset, size-l.offset))
+		l.offset += n
+	} else {
+		l.offset = size
+	}
+	if l.offset >= l.maxSize {
+		_ = l.rotate()
+	}
+}
+
+// rotate copies the file to one with suffix ".1", after shifting the
+// suffixes of the previously rotated ones, and truncates the file
+func (l *outputLog) rotate() error {
+	_ = os.Remove(fmt.Sprintf("%s.%d", l.path, l.maxFiles))
+	for i := l.maxFiles - 1; i > 0; i-- {
+		_ = os.Rename(fmt.Sprintf("%s.%d", l.path, i), fmt.Sprintf("%s.%d", l.path, i+1))
+	}
+	if l.maxFiles > 0 {
+		rotated, err := os.OpenFile(l.path+".1", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(rotated, io.NewSectionReader(l.reader, 0, math.MaxInt64))
+		_ = rotated.Close()
+		if err != nil {
+			return err
+		}
+	}
+	l.offset = 0
+	// The process appends to the file, so it writes from its start again
+	return l.file.Truncate(0)
+}
+
+// Close stops following the file, once what's left of it is followed.
+// The process may keep writing to the file.
+func (l *outputLog) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.closedCh)
+		<-l.doneCh
+		_ = l.reader.Close()
+		_ = l.file.Close()
+	})
+	return nil
+}
@@ -0,0 +1,82 @@
+package local
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputLog(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	path := filepath.Join(t.TempDir(), "stdout.log")
+	assert.NoError(os.WriteFile(path, []byte("line0\n"), 0o644))
+	readFile := func(suffix string) string {
+		b, _ := os.ReadFile(path + suffix)
+		return string(b)
+	}
+
+	redirect := &bytes.Buffer{}
+	l, err := newOutputLog(path, 18, 2, redirect)
+	assert.NoError(err)
+	// Each time the file reaches the max size, it's rotated,
+	// and only the last 2 rotated files are kept
+	for i, lines := range []string{"line1\nline2\nline3\n", "line4\nline5\nline6\n", "line7\nline8\nline9\n"} {
+		_, err := l.file.WriteString(lines)
+		assert.NoError(err)
+		// The file is rotated along with what it had when opened
+		rotated := lines
+		if i == 0 {
+			rotated = "line0\n" + lines
+		}
+		assert.Eventually(func() bool {
+			return readFile(".1") == rotated && readFile("") == ""
+		}, 5*time.Second, 10*time.Millisecond, "rotation %d", i)
+	}
+	assert.Equal("line4\nline5\nline6\n", readFile(".2"))
+	_, err = os.Stat(path + ".3")
+	assert.True(os.IsNotExist(err))
+
+	// The process writes to the file, and not to the runner
+	_, err = l.file.WriteString("line10\n")
+	assert.NoError(err)
+	assert.NoError(l.Close())
+	assert.Equal("line10\n", readFile(""))
+	// What was written before the log was opened isn't redirected
+	assert.Equal("line1\nline2\nline3\nline4\nline5\nline6\nline7\nline8\nline9\nline10\n", redirect.String())
+}
+
+func TestNodeProcessOutputLogs(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	dir := t.TempDir()
+	buf := &lockedBuffer{writtenCh: make(chan struct{})}
+	npc := &nodeProcessCreator{colorPicker: utils.NewColorPicker(), stdout: buf}
+
+	proc, err := npc.NewNodeProcess(node.Config{
+		BinaryPath:     "sh",
+		Name:           "node1",
+		RedirectStdout: true,
+		OutputLogs:     node.OutputLogs{Dir: dir},
+	}, "-c", "echo out; echo err >&2")
+	assert.NoError(err)
+	assert.NoError(proc.Start())
+	assert.NoError(proc.Wait())
+	// The redirected output is read from the file
+	<-buf.writtenCh
+	assert.True(strings.Contains(buf.String(), "[node1] out"))
+
+	stdout, err := os.ReadFile(filepath.Join(dir, node.StdoutLogFileName))
+	assert.NoError(err)
+	assert.Equal("out\n", string(stdout))
+	stderr, err := os.ReadFile(filepath.Join(dir, node.StderrLogFileName))
+	assert.NoError(err)
+	assert.Equal("err\n", string(stderr))
+}
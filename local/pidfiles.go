@@ -0,0 +1,249 @@
+package local
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/prometheus/procfs"
+)
+
+const (
+	// Subdir of the root dir of a network holding the pidfiles of its node processes
+	pidsSubDir = "pids"
+	pidFileExt = ".pid"
+)
+
+// pidFile records a node process started by a network runner,
+// so that it can be found if the runner exits without stopping it,
+// and adopted by the next runner of the network (see ResumeNetwork).
+// The start times tell the processes apart from later processes
+// with the same PIDs.
+type pidFile struct {
+	NodeName   string `json:"nodeName"`
+	BinaryPath string `json:"binaryPath"`
+	// Also the ID of the process group of the node
+	PID int `json:"pid"`
+	// In clock ticks since boot. 0 if unknown.
+	StartTime       uint64 `json:"startTime"`
+	RunnerPID       int    `json:"runnerPID"`
+	RunnerStartTime uint64 `json:"runnerStartTime"`
+
+	// What the node was started with. Empty in the
+	// pidfiles written by older runners.
+	Args    []string `json:"args,omitempty"`
+	Host    string   `json:"host,omitempty"`
+	IP      net.IP   `json:"ip,omitempty"`
+	APIPort uint16   `json:"apiPort,omitempty"`
+	P2PPort uint16   `json:"p2pPort,omitempty"`
+	DBDir   string   `json:"dbDir,omitempty"`
+	LogsDir string   `json:"logsDir,omitempty"`
+}
+
+// Orphan is a node process left running by a network runner
+// that exited without stopping it
+type Orphan struct {
+	// Root dir of the network of the node
+	RootDir    string
+	NodeName   string
+	BinaryPath string
+	PID        int
+}
+
+// writePIDFile records [process], the process of [node], in the
+// pidfile registry under [ln.rootDir]. Processes that don't run on
+// this host aren't recorded.
+// Reads the config of [node] without its lock, which the caller may hold.
+func (ln *localNetwork) writePIDFile(node *localNode, process NodeProcess) {
+	p, ok := process.(pidProcess)
+	if !ok {
+		return
+	}
+	nodeName := node.name
+	f := pidFile{
+		NodeName:   nodeName,
+		BinaryPath: node.config.BinaryPath,
+		PID:        p.getPID(),
+		RunnerPID:  os.Getpid(),
+		Args:       node.args,
+		Host:       node.host,
+		IP:         node.ip,
+		APIPort:    node.apiPort,
+		P2PPort:    node.p2pPort,
+		DBDir:      node.dbDir,
+		LogsDir:    node.logsDir,
+	}
+	// Unknown where /proc isn't available
+	f.StartTime, _, _ = getProcessStartTime(f.PID)
+	f.RunnerStartTime, _, _ = getProcessStartTime(f.RunnerPID)
+	b, err := json.Marshal(f)
+	if err == nil {
+		err = createFileAndWrite(ln.getPIDFilePath(nodeName), b)
+	}
+	if err != nil {
+		ln.log.Warn("couldn't write pidfile of node %q: %s", nodeName, err)
+	}
+}
+
+// removePIDFile removes the pidfile of node [nodeName], once [process]
+// exited, unless the pidfile records another process of the node.
+func (ln *localNetwork) removePIDFile(nodeName string, process NodeProcess) {
+	p, ok := process.(pidProcess)
+	if !ok {
+		return
+	}
+	path := ln.getPIDFilePath(nodeName)
+	f, err := readPIDFile(path)
+	if err != nil || f.PID != p.getPID() {
+		return
+	}
+	if err := os.Remove(path); err != nil {
+		ln.log.Warn("couldn't remove pidfile of node %q: %s", nodeName, err)
+	}
+}
+
+func (ln *localNetwork) getPIDFilePath(nodeName string) string {
+	return filepath.Join(ln.rootDir, pidsSubDir, nodeName+pidFileExt)
+}
+
+func readPIDFile(path string) (pidFile, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return pidFile{}, err
+	}
+	var f pidFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return pidFile{}, fmt.Errorf("couldn't unmarshal pidfile %s: %w", path, err)
+	}
+	return f, nil
+}
+
+// ReapOrphans finds the node processes recorded in the pidfiles under
+// the network root dirs [rootDirs] whose network runner isn't running
+// anymore, and kills them with their process groups, which include the
+// processes they started. The pidfiles of the killed processes, and
+// the ones of processes that already exited, are removed.
+// If [dryRun], the orphans are only returned.
+// The orphans found are returned even if an error is.
+// Needs /proc, to tell node processes apart from later processes with
+// the same PIDs.
+func ReapOrphans(rootDirs []string, dryRun bool) ([]Orphan, error) {
+	if _, err := procfs.NewDefaultFS(); err != nil {
+		return nil, fmt.Errorf("couldn't read /proc: %w", err)
+	}
+	orphans := []Orphan{}
+	errs := wrappers.Errs{}
+	for _, rootDir := range rootDirs {
+		paths, err := filepath.Glob(filepath.Join(rootDir, pidsSubDir, "*"+pidFileExt))
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			f, err := readPIDFile(path)
+			if err != nil {
+				errs.Add(err)
+				continue
+			}
+			if isProcessRunning(f.RunnerPID, f.RunnerStartTime) {
+				// Not an orphan
+				continue
+			}
+			orphan, err := reapOrphan(f, dryRun)
+			if err != nil {
+				errs.Add(fmt.Errorf("couldn't kill node %q (pid %d): %w", f.NodeName, f.PID, err))
+				continue
+			}
+			if orphan {
+				orphans = append(orphans, Orphan{
+					RootDir:    rootDir,
+					NodeName:   f.NodeName,
+					BinaryPath: f.BinaryPath,
+					PID:        f.PID,
+				})
+			}
+			if dryRun {
+				continue
+			}
+			if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				errs.Add(err)
+			}
+		}
+	}
+	return orphans, errs.Err
+}
+
+// reapOrphan sends a SIGKILL to the process group of the process of [f],
+// or only checks that the group exists if [dryRun].
+// Returns false if no process of the group is left.
+func reapOrphan(f pidFile, dryRun bool) (bool, error) {
+	startTime, running, err := getProcessStartTime(f.PID)
+	if err != nil {
+		return false, err
+	}
+	if running && f.StartTime != 0 && startTime != f.StartTime {
+		// The PID was reused, which can't happen while
+		// the process group of the node has processes left
+		return false, nil
+	}
+	sig := syscall.SIGKILL
+	if dryRun {
+		sig = 0
+	}
+	if err := syscall.Kill(-f.PID, sig); err != nil {
+		if errors.Is(err, syscall.ESRCH) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// isProcessRunning returns true if process [pid] is running,
+// and if [startTime] isn't 0, was started at [startTime]
+func isProcessRunning(pid int, startTime uint64) bool {
+	actualStartTime, running, err := getProcessStartTime(pid)
+	if err != nil || !running {
+		return false
+	}
+	return startTime == 0 || actualStartTime == startTime
+}
+
+// isProcessStopped returns true if process [pid] is stopped
+// by a signal, e.g. a SIGSTOP sent to pause it
+func isProcessStopped(pid int) bool {
+	proc, err := procfs.NewProc(pid)
+	if err != nil {
+		return false
+	}
+	stat, err := proc.Stat()
+	return err == nil && stat.State == "T"
+}
+
+// getProcessStartTime returns the start time of process [pid], in clock
+// ticks since boot, and false if there's no such process
+func getProcessStartTime(pid int) (uint64, bool, error) {
+	proc, err := procfs.NewProc(pid)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	stat, err := proc.Stat()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) || errors.Is(err, syscall.ESRCH) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	if stat.State == "Z" {
+		// Exited, but not waited for yet
+		return 0, false, nil
+	}
+	return stat.Starttime, true, nil
+}
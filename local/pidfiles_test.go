@@ -0,0 +1,139 @@
+package local
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeSleepBinary writes a script that ignores its args and sleeps
+func writeSleepBinary(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "avalanchego")
+	assert.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\nexec sleep 60\n"), 0o755))
+	return path
+}
+
+func TestPIDFiles(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS != "linux" {
+		t.Skip("processes are told apart through /proc")
+	}
+	assert := assert.New(t)
+	rootDir := t.TempDir()
+	ln, err := newLocalNetwork(logging.NoLog{}, newMockAPISuccessful, nil, rootDir, "", nil)
+	assert.NoError(err)
+
+	npc := &nodeProcessCreator{colorPicker: utils.NewColorPicker()}
+	process, err := npc.NewNodeProcess(node.Config{BinaryPath: writeSleepBinary(t)})
+	assert.NoError(err)
+	assert.NoError(process.Start())
+	ln.writePIDFile(&localNode{
+		name:    "node1",
+		config:  node.Config{BinaryPath: "avalanchego"},
+		args:    []string{"--http-port=9650"},
+		apiPort: 9650,
+	}, process)
+
+	path := filepath.Join(rootDir, pidsSubDir, "node1"+pidFileExt)
+	f, err := readPIDFile(path)
+	assert.NoError(err)
+	assert.Equal("node1", f.NodeName)
+	assert.Equal("avalanchego", f.BinaryPath)
+	assert.Equal([]string{"--http-port=9650"}, f.Args)
+	assert.Equal(uint16(9650), f.APIPort)
+	assert.Equal(process.(pidProcess).getPID(), f.PID)
+	assert.Equal(os.Getpid(), f.RunnerPID)
+	assert.True(isProcessRunning(f.PID, f.StartTime))
+	assert.True(isProcessRunning(f.RunnerPID, f.RunnerStartTime))
+
+	// the processes of a running runner aren't orphans
+	orphans, err := ReapOrphans([]string{rootDir}, false)
+	assert.NoError(err)
+	assert.Empty(orphans)
+
+	assert.NoError(process.Stop())
+	assert.Error(process.Wait())
+	ln.removePIDFile("node1", process)
+	_, err = os.Stat(path)
+	assert.True(os.IsNotExist(err))
+}
+
+func TestReapOrphans(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS != "linux" {
+		t.Skip("processes are told apart through /proc")
+	}
+	assert := assert.New(t)
+	rootDir := t.TempDir()
+
+	// a runner that exited
+	runner := exec.Command("true")
+	assert.NoError(runner.Run())
+	// and a node it left running, with a child of its own
+	orphan := exec.Command("sh", "-c", "sleep 60 & wait")
+	orphan.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	assert.NoError(orphan.Start())
+	orphanExited := make(chan struct{})
+	go func() {
+		_ = orphan.Wait()
+		close(orphanExited)
+	}()
+	startTime, running, err := getProcessStartTime(orphan.Process.Pid)
+	assert.NoError(err)
+	assert.True(running)
+
+	ln := &localNetwork{rootDir: rootDir}
+	writePIDFile := func(f pidFile) {
+		b, err := json.Marshal(f)
+		assert.NoError(err)
+		assert.NoError(createFileAndWrite(ln.getPIDFilePath(f.NodeName), b))
+	}
+	writePIDFile(pidFile{
+		NodeName:   "node1",
+		BinaryPath: "avalanchego",
+		PID:        orphan.Process.Pid,
+		StartTime:  startTime,
+		RunnerPID:  runner.Process.Pid,
+	})
+	// a node that already exited
+	writePIDFile(pidFile{
+		NodeName:  "node2",
+		PID:       runner.Process.Pid,
+		RunnerPID: runner.Process.Pid,
+	})
+	path := ln.getPIDFilePath("node1")
+
+	orphans, err := ReapOrphans([]string{rootDir}, true)
+	assert.NoError(err)
+	assert.Equal([]Orphan{{RootDir: rootDir, NodeName: "node1", BinaryPath: "avalanchego", PID: orphan.Process.Pid}}, orphans)
+	// only found
+	_, err = os.Stat(path)
+	assert.NoError(err)
+	assert.NoError(syscall.Kill(-orphan.Process.Pid, 0))
+
+	orphans, err = ReapOrphans([]string{rootDir}, false)
+	assert.NoError(err)
+	assert.Len(orphans, 1)
+	select {
+	case <-orphanExited:
+	case <-time.After(5 * time.Second):
+		assert.Fail("orphan not killed")
+	}
+	// the whole process group was killed
+	assert.Eventually(func() bool {
+		return syscall.Kill(-orphan.Process.Pid, 0) != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	entries, err := os.ReadDir(filepath.Join(rootDir, pidsSubDir))
+	assert.NoError(err)
+	assert.Empty(entries)
+}
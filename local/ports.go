@@ -0,0 +1,133 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+)
+
+func init() {
+	rand.Seed(time.Now().UnixNano())
+}
+
+const (
+	maxPort          = math.MaxUint16
+	minPort          = 10000
+	netListenTimeout = 3 * time.Second
+)
+
+// Registry of the ports used by the nodes of all the
+// local networks of this process
+var ports = newPortAllocator()
+
+// portAllocator hands out ports that are free on this host.
+// It keeps track of the ports it handed out, or that were reserved,
+// until they are released, so it never hands out the same port twice,
+// even if the node it was handed out to didn't bind it yet.
+type portAllocator struct {
+	lock     sync.Mutex
+	reserved map[uint16]struct{}
+}
+
+func newPortAllocator() *portAllocator {
+	return &portAllocator{
+		reserved: map[uint16]struct{}{},
+	}
+}
+
+// allocate returns a port of [portRange] that is neither reserved
+// nor bound on this host, and reserves it.
+// If [portRange] is the zero value, a random port in [minPort, maxPort]
+// is returned, or an error if none is found within [netListenTimeout].
+// Otherwise the ports of [portRange] are tried in increasing order.
+func (a *portAllocator) allocate(portRange network.PortRange) (uint16, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if !portRange.IsZero() {
+		for port := uint32(portRange.Min); port <= uint32(portRange.Max); port++ {
+			if a.tryReserve(uint16(port)) {
+				return uint16(port), nil
+			}
+		}
+		return 0, fmt.Errorf("no free port in range [%d, %d]", portRange.Min, portRange.Max)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), netListenTimeout)
+	defer cancel()
+	for {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		default:
+			// Generate random port in [minPort, maxPort]
+			port := uint16(rand.Intn(maxPort-minPort+1) + minPort)
+			if a.tryReserve(port) {
+				return port, nil
+			}
+		}
+	}
+}
+
+// tryReserve reserves [port] if it's neither reserved nor bound.
+// Returns true if it was reserved.
+// Assumes [a.lock] is held.
+func (a *portAllocator) tryReserve(port uint16) bool {
+	if _, ok := a.reserved[port]; ok {
+		return false
+	}
+	if !isPortFree(port) {
+		return false
+	}
+	a.reserved[port] = struct{}{}
+	return true
+}
+
+// reserve marks [port], which was chosen by the caller, as used.
+// Returns false if it was already reserved.
+func (a *portAllocator) reserve(port uint16) bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if _, ok := a.reserved[port]; ok {
+		return false
+	}
+	a.reserved[port] = struct{}{}
+	return true
+}
+
+// release makes [ports] available again
+func (a *portAllocator) release(ports ...uint16) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	for _, port := range ports {
+		delete(a.reserved, port)
+	}
+}
+
+// isPortFree returns true if [port] can be bound on this host
+func isPortFree(port uint16) bool {
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return false
+	}
+	_ = l.Close()
+	return true
+}
+
+// anyPortBound returns true if one of [ports] is bound on this host
+func anyPortBound(ports []uint16) bool {
+	for _, port := range ports {
+		if !isPortFree(port) {
+			return true
+		}
+	}
+	return false
+}
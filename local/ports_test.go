@@ -0,0 +1,40 @@
+package local
+
+import (
+	"net"
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPortAllocator(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	allocator := newPortAllocator()
+
+	// Random ports are never handed out twice
+	port1, err := allocator.allocate(network.PortRange{})
+	assert.NoError(err)
+	port2, err := allocator.allocate(network.PortRange{})
+	assert.NoError(err)
+	assert.NotEqual(port1, port2)
+	assert.False(allocator.reserve(port1))
+
+	// Reserved ports of a range are skipped
+	_, err = allocator.allocate(network.PortRange{Min: port1, Max: port1})
+	assert.Error(err)
+	allocator.release(port1)
+	port, err := allocator.allocate(network.PortRange{Min: port1, Max: port1})
+	assert.NoError(err)
+	assert.Equal(port1, port)
+
+	// Bound ports are skipped
+	l, err := net.Listen("tcp", ":0")
+	assert.NoError(err)
+	defer l.Close()
+	boundPort := uint16(l.Addr().(*net.TCPAddr).Port)
+	_, err = allocator.allocate(network.PortRange{Min: boundPort, Max: boundPort})
+	assert.Error(err)
+	assert.True(anyPortBound([]uint16{port2, boundPort}))
+}
@@ -0,0 +1,593 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanchego/config"
+	avago_utils "github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/beacon"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+const (
+	// Time to wait for the node behind a proxy to accept a connection
+	proxyDialTimeout = 5 * time.Second
+	// Size of the buffer used to copy the traffic of a connection
+	proxyBufferSize = 32 * 1024
+	// Max number of buffers of traffic of a connection waiting to be
+	// delivered, after which no more is read from the connection
+	proxyQueueSize = 64
+	// Time between checks of the peers of a node behind a proxy
+	proxyCheckFreq = 10 * time.Second
+	// Time to wait for a node to list its peers
+	proxyCheckTimeout = 5 * time.Second
+)
+
+// IP the proxy links listen on, which the nodes bootstrap from
+var proxyLinkIP = net.IPv6loopback
+
+// Flags given to each node of a network that proxies P2P traffic,
+// unless given in its flags or config file
+var p2pProxyFlags = map[string]interface{}{
+	// Nodes must only learn about each other from their bootstrap
+	// list, which holds proxy addresses, and not from the addresses
+	// their peers advertise.
+	config.NetworkPeerListNumValidatorIPsKey:        0,
+	config.NetworkPeerListValidatorGossipSizeKey:    0,
+	config.NetworkPeerListNonValidatorGossipSizeKey: 0,
+	config.NetworkPeerListPeersGossipSizeKey:        0,
+}
+
+// nodeProxy forwards to the P2P port of a node the traffic sent to it by
+// the other nodes of the network. Each of them reaches the node through
+// its own link, listening on its own port, so the proxy knows which node
+// the traffic comes from.
+type nodeProxy struct {
+	log logging.Logger
+	// Address of the P2P port of the node
+	target string
+	lock   sync.Mutex
+	// Peer node name --> link the peer reaches the node through
+	links map[string]*proxyLink
+}
+
+func newNodeProxy(log logging.Logger, host string, p2pPort uint16) *nodeProxy {
+	return &nodeProxy{
+		log:    log,
+		target: net.JoinHostPort(host, fmt.Sprintf("%d", p2pPort)),
+		links:  map[string]*proxyLink{},
+	}
+}
+
+// addLink starts the link node [peerName] reaches the node through,
+// listening on [port], which is released when the link is closed.
+// Replaces the previous link of [peerName], if any.
+func (p *nodeProxy) addLink(peerName string, port uint16) (*proxyLink, error) {
+	link, err := newProxyLink(p.log, port, p.target)
+	if err != nil {
+		return nil, err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if previousLink, ok := p.links[peerName]; ok {
+		previousLink.close()
+	}
+	p.links[peerName] = link
+	return link, nil
+}
+
+// removeLink closes the link of node [peerName], if any
+func (p *nodeProxy) removeLink(peerName string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if link, ok := p.links[peerName]; ok {
+		link.close()
+		delete(p.links, peerName)
+	}
+}
+
+// getLinks returns the links of the proxy, by peer node name
+func (p *nodeProxy) getLinks() map[string]*proxyLink {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	links := make(map[string]*proxyLink, len(p.links))
+	for peerName, link := range p.links {
+		links[peerName] = link
+	}
+	return links
+}
+
+// close closes all the links of the proxy
+func (p *nodeProxy) close() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for peerName, link := range p.links {
+		link.close()
+		delete(p.links, peerName)
+	}
+}
+
+// proxyLink forwards the connections accepted on its port to a node.
+// Blocking it closes its connections, and new ones are refused until
+// it's unblocked.
+// The traffic in both directions goes through the faults of the link.
+type proxyLink struct {
+	log      logging.Logger
+	port     uint16
+	listener net.Listener
+	// Address connections are forwarded to
+	target string
+	lock   sync.Mutex
+	// True while the traffic through this link is blocked
+	blocked bool
+	// Faults injected in the traffic through this link
+	faults network.LinkFaults
+	// Open connections, from the peer and to the target
+	conns map[net.Conn]struct{}
+	// Closed when the link is closed
+	closedCh  chan struct{}
+	closeOnce sync.Once
+}
+
+func newProxyLink(log logging.Logger, port uint16, target string) (*proxyLink, error) {
+	listener, err := net.Listen("tcp", net.JoinHostPort(proxyLinkIP.String(), fmt.Sprintf("%d", port)))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't listen on proxy port %d: %w", port, err)
+	}
+	link := &proxyLink{
+		log:      log,
+		port:     port,
+		listener: listener,
+		target:   target,
+		conns:    map[net.Conn]struct{}{},
+		closedCh: make(chan struct{}),
+	}
+	go link.accept()
+	return link, nil
+}
+
+// setBlocked blocks or unblocks the traffic through the link.
+// Blocking it closes its connections.
+func (l *proxyLink) setBlocked(blocked bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.blocked = blocked
+	if blocked {
+		for conn := range l.conns {
+			_ = conn.Close()
+		}
+		l.conns = map[net.Conn]struct{}{}
+	}
+}
+
+// isBlocked returns true if the traffic through the link is blocked
+func (l *proxyLink) isBlocked() bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.blocked
+}
+
+// setFaults replaces the faults injected in the traffic through the link.
+// They apply to the traffic read from then on.
+func (l *proxyLink) setFaults(faults network.LinkFaults) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.faults = faults
+}
+
+// getFaults returns the faults injected in the traffic through the link
+func (l *proxyLink) getFaults() network.LinkFaults {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.faults
+}
+
+// addrs returns the addresses the connections through the link come from,
+// as seen by the nodes on each side: the address of the link, for the peer,
+// and the local addresses of the connections to the target, for the target
+func (l *proxyLink) addrs() map[string]struct{} {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	addrs := map[string]struct{}{
+		l.listener.Addr().String(): {},
+	}
+	for conn := range l.conns {
+		addrs[conn.LocalAddr().String()] = struct{}{}
+	}
+	return addrs
+}
+
+// close stops the link, closing its connections, and releases its port
+func (l *proxyLink) close() {
+	l.closeOnce.Do(func() {
+		close(l.closedCh)
+		_ = l.listener.Close()
+
+		l.lock.Lock()
+		for conn := range l.conns {
+			_ = conn.Close()
+		}
+		l.conns = map[net.Conn]struct{}{}
+		l.lock.Unlock()
+
+		ports.release(l.port)
+	})
+}
+
+// accept forwards the connections accepted on the link until it's closed
+func (l *proxyLink) accept() {
+	for {
+		conn, err := l.listener.Accept()
+		if err != nil {
+			// The listener was closed
+			return
+		}
+		go l.handle(conn)
+	}
+}
+
+// handle forwards the traffic of [conn] to the target, and back
+func (l *proxyLink) handle(conn net.Conn) {
+	if l.isBlocked() || rand.Float64() < l.getFaults().DropProbability {
+		// The peer retries to connect through the link
+		_ = conn.Close()
+		return
+	}
+	targetConn, err := net.DialTimeout("tcp", l.target, proxyDialTimeout)
+	if err != nil {
+		l.log.Debug("proxy couldn't connect to %s: %s", l.target, err)
+		_ = conn.Close()
+		return
+	}
+
+	l.lock.Lock()
+	select {
+	case <-l.closedCh:
+		l.lock.Unlock()
+		_ = conn.Close()
+		_ = targetConn.Close()
+		return
+	default:
+	}
+	if l.blocked {
+		// Blocked meanwhile
+		l.lock.Unlock()
+		_ = conn.Close()
+		_ = targetConn.Close()
+		return
+	}
+	l.conns[conn] = struct{}{}
+	l.conns[targetConn] = struct{}{}
+	l.lock.Unlock()
+
+	go l.forward(targetConn, conn)
+	l.forward(conn, targetConn)
+}
+
+// proxyChunk is a piece of traffic read from a connection
+type proxyChunk struct {
+	data []byte
+	// When the chunk is due to be written
+	due time.Time
+}
+
+// forward copies the traffic of [src] to [dst], delaying it and limiting
+// its throughput as the faults of the link say.
+// When either connection fails, both are closed.
+func (l *proxyLink) forward(dst net.Conn, src net.Conn) {
+	defer l.closeConns(dst, src)
+
+	chunks := make(chan proxyChunk, proxyQueueSize)
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	go l.read(src, chunks, doneCh)
+
+	for chunk := range chunks {
+		faults := l.getFaults()
+		if !l.waitUntil(chunk.due) {
+			return
+		}
+		if faults.Bandwidth > 0 {
+			// Wait as long as sending the chunk takes at that throughput
+			sendTime := time.Duration(uint64(len(chunk.data)) * uint64(time.Second) / faults.Bandwidth)
+			if !l.waitUntil(time.Now().Add(sendTime)) {
+				return
+			}
+		}
+		if _, err := dst.Write(chunk.data); err != nil {
+			return
+		}
+	}
+}
+
+// read sends to [chunks] the traffic read from [src], each chunk being due
+// after the delay of the link, until [src] fails or [doneCh] is closed.
+// Closes [chunks] when done.
+func (l *proxyLink) read(src net.Conn, chunks chan<- proxyChunk, doneCh <-chan struct{}) {
+	defer close(chunks)
+
+	var lastDue time.Time
+	for {
+		buf := make([]byte, proxyBufferSize)
+		n, err := src.Read(buf)
+		if n > 0 {
+			due := time.Now().Add(l.getFaults().SampleDelay())
+			// Jitter must not reorder the traffic
+			if due.Before(lastDue) {
+				due = lastDue
+			}
+			lastDue = due
+			select {
+			case chunks <- proxyChunk{data: buf[:n], due: due}:
+			case <-doneCh:
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// waitUntil waits until [t].
+// Returns false if the link is closed meanwhile.
+func (l *proxyLink) waitUntil(t time.Time) bool {
+	d := time.Until(t)
+	if d <= 0 {
+		return true
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-l.closedCh:
+		return false
+	}
+}
+
+// closeConns closes [conns] and stops tracking them
+func (l *proxyLink) closeConns(conns ...net.Conn) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	for _, conn := range conns {
+		_ = conn.Close()
+		delete(l.conns, conn)
+	}
+}
+
+// addP2PProxyFlags adds [p2pProxyFlags] to [nodeFlags],
+// unless given in [nodeFlags] or [configFile]
+func addP2PProxyFlags(configFile map[string]interface{}, nodeFlags map[string]interface{}) {
+	for flagName, flagVal := range p2pProxyFlags {
+		if _, ok := nodeFlags[flagName]; ok {
+			continue
+		}
+		if _, ok := configFile[flagName]; ok {
+			continue
+		}
+		nodeFlags[flagName] = flagVal
+	}
+}
+
+// addProxyLinks adds to the proxy of each node of the network a link
+// for node [nodeName], and returns the set of those links, which the
+// node bootstraps from. Every node being a bootstrap, and gossip being
+// off, nodes only connect to each other through the proxies.
+// If [bootstrapNodes] isn't empty, only the links to those nodes are returned.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) addProxyLinks(nodeName string, bootstrapNodes []string) (beacon.Set, error) {
+	isBootstrap := make(map[string]bool, len(bootstrapNodes))
+	for _, bootstrapNode := range bootstrapNodes {
+		isBootstrap[bootstrapNode] = true
+	}
+	bootstraps := beacon.NewSet()
+	for _, node := range ln.nodes {
+		port, err := ports.allocate(ln.portRange)
+		if err != nil {
+			ln.removeProxyLinks(nodeName)
+			return nil, fmt.Errorf("couldn't get free proxy port: %w", err)
+		}
+		link, err := node.proxy.addLink(nodeName, port)
+		if err != nil {
+			ports.release(port)
+			ln.removeProxyLinks(nodeName)
+			return nil, err
+		}
+		link.setBlocked(ln.isPartitioned(node.name, nodeName))
+		link.setFaults(ln.getLinkFaults(node.name, nodeName))
+		if len(bootstrapNodes) > 0 && !isBootstrap[node.name] {
+			continue
+		}
+		if err := bootstraps.Add(beacon.New(node.nodeID, avago_utils.IPDesc{
+			IP:   proxyLinkIP,
+			Port: port,
+		})); err != nil {
+			ln.removeProxyLinks(nodeName)
+			return nil, err
+		}
+	}
+	return bootstraps, nil
+}
+
+// removeProxyLinks closes the links of node [nodeName] to the other nodes
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) removeProxyLinks(nodeName string) {
+	for _, node := range ln.nodes {
+		if node.proxy != nil {
+			node.proxy.removeLink(nodeName)
+		}
+	}
+}
+
+// checkProxyBypasses checks every [proxyCheckFreq] that [node] only
+// reaches its peers through the proxies, until the network asks it to stop.
+// Each connection that bypasses them is logged, and published as an event,
+// once.
+func (ln *localNetwork) checkProxyBypasses(node *localNode) {
+	// Peer node name --> address of the connection to it reported last
+	reported := map[string]string{}
+	for {
+		select {
+		case <-node.stopCh:
+			return
+		case <-time.After(proxyCheckFreq):
+		}
+		bypasses, err := ln.getProxyBypasses(node)
+		if err != nil {
+			ln.log.Debug("couldn't check the peers of node %q: %s", node.name, err)
+			continue
+		}
+		for peerName, addr := range bypasses {
+			if reported[peerName] == addr {
+				continue
+			}
+			msg := fmt.Sprintf("connected to node %q at %s, bypassing the proxies", peerName, addr)
+			ln.log.Warn("node %q is %s", node.name, msg)
+			ln.events.Publish(network.NewEvent(network.EventProxyBypassed, node.name, msg))
+		}
+		reported = bypasses
+	}
+}
+
+// getProxyBypasses returns the address of each connection of [node] to
+// another node of the network that doesn't go through a proxy link,
+// by name of that node
+func (ln *localNetwork) getProxyBypasses(node *localNode) (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), proxyCheckTimeout)
+	peers, err := node.client.InfoAPI().Peers(ctx)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+
+	ln.lock.RLock()
+	defer ln.lock.RUnlock()
+
+	nodesByID := make(map[string]*localNode, len(ln.nodes))
+	for _, n := range ln.nodes {
+		nodesByID[n.nodeID.PrefixedString(constants.NodeIDPrefix)] = n
+	}
+	bypasses := map[string]string{}
+	for _, peer := range peers {
+		peerNode, ok := nodesByID[peer.ID]
+		if !ok || peerNode.proxy == nil {
+			// Not a node of the network, e.g. an attached test peer
+			continue
+		}
+		if _, ok := linkAddrs(node, peerNode)[peer.IP]; !ok {
+			bypasses[peerNode.name] = peer.IP
+		}
+	}
+	return bypasses, nil
+}
+
+// linkAddrs returns the addresses the connections through the proxy link
+// between [node1] and [node2] come from, as seen by either node
+func linkAddrs(node1 *localNode, node2 *localNode) map[string]struct{} {
+	addrs := map[string]struct{}{}
+	for _, link := range []*proxyLink{
+		node1.proxy.getLinks()[node2.name],
+		node2.proxy.getLinks()[node1.name],
+	} {
+		if link == nil {
+			continue
+		}
+		for addr := range link.addrs() {
+			addrs[addr] = struct{}{}
+		}
+	}
+	return addrs
+}
+
+// applyPartition blocks the proxy links between the nodes in different
+// groups of [ln.partition], closing their connections.
+// The nodes may reconnect through the addresses their peers advertise,
+// bypassing the proxies, which [checkProxyBypasses] reports.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) applyPartition() {
+	for _, node := range ln.nodes {
+		if node.proxy == nil {
+			continue
+		}
+		for peerName, link := range node.proxy.getLinks() {
+			link.setBlocked(ln.isPartitioned(node.name, peerName))
+		}
+	}
+}
+
+// isPartitioned returns true if nodes [nodeName1] and [nodeName2]
+// are in different groups of the current partition
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) isPartitioned(nodeName1 string, nodeName2 string) bool {
+	if ln.partition == nil {
+		return false
+	}
+	return ln.partitionGroup(nodeName1) != ln.partitionGroup(nodeName2)
+}
+
+// partitionGroup returns the index of the group of node [nodeName]
+// in the current partition, or -1 if it isn't in any.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) partitionGroup(nodeName string) int {
+	if group, ok := ln.partition[nodeName]; ok {
+		return group
+	}
+	return -1
+}
+
+// applyFaults injects in the traffic through each proxy
+// link the faults of the nodes it's between
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) applyFaults() {
+	for _, node := range ln.nodes {
+		if node.proxy == nil {
+			continue
+		}
+		for peerName, link := range node.proxy.getLinks() {
+			link.setFaults(ln.getLinkFaults(node.name, peerName))
+		}
+	}
+}
+
+// getLinkFaults returns the faults injected in the traffic between nodes
+// [nodeName1] and [nodeName2]: the faults set for that link if any, or else
+// the faults of both nodes added up.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) getLinkFaults(nodeName1 string, nodeName2 string) network.LinkFaults {
+	if faults, ok := ln.linkFaults[newLinkKey(nodeName1, nodeName2)]; ok {
+		return faults
+	}
+	return ln.nodeFaults[nodeName1].Add(ln.nodeFaults[nodeName2])
+}
+
+// linkKey identifies the link between two nodes, regardless of their order
+type linkKey struct {
+	nodeName1 string
+	nodeName2 string
+}
+
+func newLinkKey(nodeName1 string, nodeName2 string) linkKey {
+	if nodeName1 > nodeName2 {
+		nodeName1, nodeName2 = nodeName2, nodeName1
+	}
+	return linkKey{nodeName1: nodeName1, nodeName2: nodeName2}
+}
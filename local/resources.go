@@ -0,0 +1,150 @@
+package local
+
+import (
+	"io/fs"
+	"path/filepath"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/prometheus/procfs"
+)
+
+const (
+	// Time between samples of the resources used by a node
+	resourceSampleFreq = 5 * time.Second
+	// Number of samples kept for each node
+	resourceHistoryLen = 60
+)
+
+// resourceHistory keeps the last [resourceHistoryLen] samples
+// of the resources used by a node in a ring buffer
+type resourceHistory struct {
+	buf []node.ResourceUsage
+	// Index of the oldest sample once [buf] is full
+	next int
+}
+
+// add adds [sample], dropping the oldest one if the history is full
+func (h *resourceHistory) add(sample node.ResourceUsage) {
+	if len(h.buf) < resourceHistoryLen {
+		h.buf = append(h.buf, sample)
+		return
+	}
+	h.buf[h.next] = sample
+	h.next = (h.next + 1) % len(h.buf)
+}
+
+// last returns the last sample, if any
+func (h *resourceHistory) last() (node.ResourceUsage, bool) {
+	if len(h.buf) == 0 {
+		return node.ResourceUsage{}, false
+	}
+	return h.buf[(h.next+len(h.buf)-1)%len(h.buf)], true
+}
+
+// samples returns a copy of the samples, oldest first
+func (h *resourceHistory) samples() []node.ResourceUsage {
+	samples := make([]node.ResourceUsage, 0, len(h.buf))
+	samples = append(samples, h.buf[h.next:]...)
+	return append(samples, h.buf[:h.next]...)
+}
+
+// sampleResources samples the resources used by [node] every
+// [resourceSampleFreq], until the network asks it to stop.
+// Nothing is sampled while the process isn't running, or if it
+// doesn't run on this host.
+func (ln *localNetwork) sampleResources(node *localNode) {
+	for {
+		select {
+		case <-node.stopCh:
+			return
+		case <-time.After(resourceSampleFreq):
+		}
+		if err := node.sampleResources(); err != nil {
+			ln.log.Debug("couldn't sample the resources used by node %q: %s", node.name, err)
+		}
+	}
+}
+
+// sampleResources adds a sample of the resources used by the
+// process of [node], and of the size of its dirs, to its history
+func (node *localNode) sampleResources() error {
+	node.lock.RLock()
+	process, running := node.process, node.processStatus.Running
+	prev, hasPrev := node.resourceUsage.last()
+	node.lock.RUnlock()
+
+	p, ok := process.(pidProcess)
+	if !running || !ok {
+		return nil
+	}
+	sample, err := sampleProcess(p.getPID())
+	if err != nil {
+		return err
+	}
+	if hasPrev {
+		if elapsed := sample.Time.Sub(prev.Time).Seconds(); elapsed > 0 && sample.CPUSeconds >= prev.CPUSeconds {
+			sample.CPUPercent = 100 * (sample.CPUSeconds - prev.CPUSeconds) / elapsed
+		}
+	}
+	sample.DBDirBytes = dirSize(node.dbDir)
+	sample.LogsDirBytes = dirSize(node.logsDir)
+
+	node.lock.Lock()
+	defer node.lock.Unlock()
+
+	// Drop the sample if the process was restarted meanwhile
+	if node.process == process {
+		node.resourceUsage.add(sample)
+	}
+	return nil
+}
+
+// sampleProcess returns the resources used by the process [pid],
+// read from /proc, without the sizes of the node dirs
+func sampleProcess(pid int) (node.ResourceUsage, error) {
+	proc, err := procfs.NewProc(pid)
+	if err != nil {
+		return node.ResourceUsage{}, err
+	}
+	stat, err := proc.Stat()
+	if err != nil {
+		return node.ResourceUsage{}, err
+	}
+	sample := node.ResourceUsage{
+		Time:       time.Now(),
+		CPUSeconds: stat.CPUTime(),
+		RSSBytes:   uint64(stat.ResidentMemory()),
+		Threads:    stat.NumThreads,
+	}
+	sample.OpenFDs, err = proc.FileDescriptorsLen()
+	if err != nil {
+		return node.ResourceUsage{}, err
+	}
+	// Only readable by the owner of the process
+	if io, err := proc.IO(); err == nil {
+		sample.ReadBytes = io.ReadBytes
+		sample.WriteBytes = io.WriteBytes
+	}
+	return sample, nil
+}
+
+// dirSize returns the total size of the regular files under [dir].
+// Files that can't be read, e.g. because they were removed
+// while walking [dir], are skipped.
+func dirSize(dir string) uint64 {
+	if dir == "" {
+		return 0
+	}
+	var size uint64
+	_ = filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() {
+			return nil
+		}
+		if info, err := d.Info(); err == nil {
+			size += uint64(info.Size())
+		}
+		return nil
+	})
+	return size
+}
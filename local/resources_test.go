@@ -0,0 +1,93 @@
+package local
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/local/mocks"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceHistory(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	h := resourceHistory{}
+	_, ok := h.last()
+	assert.False(ok)
+	assert.Empty(h.samples())
+
+	start := time.Unix(0, 0)
+	for i := 0; i < resourceHistoryLen+10; i++ {
+		h.add(node.ResourceUsage{Time: start.Add(time.Duration(i) * time.Second)})
+	}
+	// Only the last samples are kept, oldest first
+	samples := h.samples()
+	assert.Len(samples, resourceHistoryLen)
+	for i, sample := range samples {
+		assert.Equal(start.Add(time.Duration(i+10)*time.Second), sample.Time)
+	}
+	last, ok := h.last()
+	assert.True(ok)
+	assert.Equal(samples[len(samples)-1], last)
+}
+
+func TestSampleResources(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS != "linux" {
+		t.Skip("resources are read from /proc")
+	}
+	assert := assert.New(t)
+	dbDir, logsDir := t.TempDir(), t.TempDir()
+	assert.NoError(os.WriteFile(filepath.Join(dbDir, "db"), make([]byte, 100), 0o644))
+	assert.NoError(os.MkdirAll(filepath.Join(logsDir, "C"), 0o755))
+	assert.NoError(os.WriteFile(filepath.Join(logsDir, "C", "C.log"), make([]byte, 10), 0o644))
+
+	npc := &nodeProcessCreator{colorPicker: utils.NewColorPicker()}
+	proc, err := npc.NewNodeProcess(node.Config{BinaryPath: "sleep"}, "10")
+	assert.NoError(err)
+	assert.NoError(proc.Start())
+	defer func() {
+		_ = proc.Stop()
+		_ = proc.Wait()
+	}()
+	// Wait for sleep to be executed
+	assert.Eventually(func() bool {
+		sample, err := sampleProcess(proc.(pidProcess).getPID())
+		return err == nil && sample.RSSBytes > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	n := &localNode{
+		process: proc,
+		dbDir:   dbDir,
+		logsDir: logsDir,
+	}
+	n.processStatus.Running = true
+
+	assert.NoError(n.sampleResources())
+	assert.NoError(n.sampleResources())
+	samples := n.GetResourceUsage()
+	assert.Len(samples, 2)
+	for _, sample := range samples {
+		assert.False(sample.Time.IsZero())
+		assert.NotZero(sample.RSSBytes)
+		assert.NotZero(sample.Threads)
+		assert.NotZero(sample.OpenFDs)
+		assert.EqualValues(100, sample.DBDirBytes)
+		assert.EqualValues(10, sample.LogsDirBytes)
+	}
+
+	// Nothing is sampled while the process isn't running,
+	// or if it doesn't run on this host
+	n.processStatus.Running = false
+	assert.NoError(n.sampleResources())
+	n.processStatus.Running = true
+	n.process = &mocks.NodeProcess{}
+	assert.NoError(n.sampleResources())
+	assert.Len(n.GetResourceUsage(), 2)
+}
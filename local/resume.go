@@ -0,0 +1,268 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/api"
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+const (
+	// Interval between the checks that an adopted process is still running
+	adoptedProcessPollFreq = 500 * time.Millisecond
+	// Interval between the checks that a killed process exited
+	killedProcessPollFreq = 10 * time.Millisecond
+)
+
+var (
+	_ NodeProcess = (*adoptedProcess)(nil)
+	_ pidProcess  = (*adoptedProcess)(nil)
+
+	errAdoptedProcessExited = errors.New("adopted process exited with unknown status")
+)
+
+// ResumeNetwork returns the network of config [networkConfig], whose
+// nodes were run under [rootDir] by a network runner that exited without
+// stopping them, e.g. one that crashed.
+// The nodes whose processes are still running, as recorded in their
+// pidfiles, are adopted, and the other ones are started again. As their
+// configs give the dirs they ran with, they keep their databases.
+// Adopted nodes that were paused stay paused, and their output logs
+// are followed, and redirected as their configs say.
+// With a P2P proxy, all the nodes are started again, as the proxies
+// they reached each other through were run by the previous runner.
+// The node processes that can't be adopted are killed first, with
+// their process groups.
+// Returns an error if the runner of one of the nodes is still running.
+// See NewNetwork for the meaning of the other params.
+func ResumeNetwork(
+	log logging.Logger,
+	networkConfig network.Config,
+	rootDir string,
+	snapshotsDir string,
+	events *network.EventBus,
+) (network.Network, error) {
+	return resumeNetwork(
+		log,
+		networkConfig,
+		api.NewAPIClient,
+		&nodeProcessCreator{
+			colorPicker: utils.NewColorPicker(),
+			stdout:      os.Stdout,
+			stderr:      os.Stderr,
+		},
+		rootDir,
+		snapshotsDir,
+		events,
+	)
+}
+
+// See ResumeNetwork.
+func resumeNetwork(
+	log logging.Logger,
+	networkConfig network.Config,
+	newAPIClientF api.NewAPIClientF,
+	nodeProcessCreator NodeProcessCreator,
+	rootDir string,
+	snapshotsDir string,
+	events *network.EventBus,
+) (network.Network, error) {
+	net, err := newLocalNetwork(log, newAPIClientF, nodeProcessCreator, rootDir, snapshotsDir, events)
+	if err != nil {
+		return nil, err
+	}
+	if err := net.resume(context.Background(), networkConfig); err != nil {
+		return nil, err
+	}
+	return net, nil
+}
+
+// resume adopts the running nodes of [networkConfig], and starts the other ones.
+// The running nodes are adopted first, so that the others bootstrap from them.
+// Assumes [ln.lock] is held, or that [ln] isn't accessible to other goroutines yet.
+func (ln *localNetwork) resume(ctx context.Context, networkConfig network.Config) error {
+	if err := ln.setConfig(networkConfig); err != nil {
+		return err
+	}
+	ln.log.Info("resuming network with %d nodes", len(networkConfig.NodeConfigs))
+	var nodeConfigs []node.Config
+	for _, nodeConfig := range networkConfig.NodeConfigs {
+		adopted, err := ln.adoptNode(nodeConfig)
+		if err != nil {
+			ln.stopOnLoadErr(ctx)
+			return fmt.Errorf("error adopting node %s: %w", nodeConfig.Name, err)
+		}
+		if !adopted {
+			nodeConfigs = append(nodeConfigs, nodeConfig)
+		}
+	}
+	return ln.startNodes(ctx, nodeConfigs)
+}
+
+// adoptNode adds the node of [nodeConfig] to the network with the process
+// recorded in its pidfile, if it's still running.
+// Returns false if the node must be started instead.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) adoptNode(nodeConfig node.Config) (bool, error) {
+	if nodeConfig.Name == "" {
+		return false, nil
+	}
+	f, err := readPIDFile(ln.getPIDFilePath(nodeConfig.Name))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	if isProcessRunning(f.RunnerPID, f.RunnerStartTime) {
+		return false, fmt.Errorf("node is run by the network runner with pid %d", f.RunnerPID)
+	}
+	if !isProcessRunning(f.PID, f.StartTime) {
+		return false, nil
+	}
+	if len(f.Args) == 0 || ln.p2pProxy {
+		// Recorded by an older runner, or reaching the other nodes through
+		// the proxies of the previous runner. Started again once killed, as
+		// it would hold the database and the ports of the new process.
+		ln.log.Info("killing node %q, running with pid %d, to start it again", nodeConfig.Name, f.PID)
+		return false, killNodeProcess(f)
+	}
+	if err := ln.setNodeName(&nodeConfig); err != nil {
+		return false, err
+	}
+	nodeID, err := utils.ToNodeID([]byte(nodeConfig.StakingKey), []byte(nodeConfig.StakingCert))
+	if err != nil {
+		return false, fmt.Errorf("couldn't get node ID: %w", err)
+	}
+	process := &adoptedProcess{pid: f.PID, startTime: f.StartTime}
+	// The node keeps writing to its output logs, which
+	// are now followed and rotated by this runner
+	if npc, ok := ln.nodeProcessCreator.(*nodeProcessCreator); ok {
+		if nodeConfig.OutputLogs.Dir == "" {
+			nodeConfig.OutputLogs.Dir = filepath.Join(ln.rootDir, nodeConfig.Name)
+		}
+		output, err := npc.newNodeOutput(nodeConfig)
+		if err != nil {
+			return false, fmt.Errorf("couldn't follow output logs: %w", err)
+		}
+		process.output = output
+	}
+	var reservedPorts []uint16
+	for _, port := range []uint16{f.APIPort, f.P2PPort} {
+		if ports.reserve(port) {
+			reservedPorts = append(reservedPorts, port)
+		} else {
+			ln.log.Warn("port %d of node %q is already used by another node", port, nodeConfig.Name)
+		}
+	}
+	if ln.distinctNodeIPs && f.IP != nil {
+		if ln.nodeIPs == nil {
+			ln.nodeIPs = map[string]net.IP{}
+		}
+		ln.nodeIPs[nodeConfig.Name] = f.IP
+	}
+
+	ln.log.Info("adopting node %q, running with pid %d", nodeConfig.Name, f.PID)
+	node, err := ln.registerNode(&pendingNode{
+		config: nodeConfig,
+		nodeID: nodeID,
+		nodeData: buildFlagsReturn{
+			flags:         f.Args,
+			apiPort:       f.APIPort,
+			p2pPort:       f.P2PPort,
+			dbDir:         f.DBDir,
+			logsDir:       f.LogsDir,
+			reservedPorts: reservedPorts,
+		},
+		process: process,
+		host:    f.Host,
+		ip:      f.IP,
+	})
+	if err != nil {
+		process.closeOutput()
+		return true, err
+	}
+	// Paused by the previous runner
+	if isProcessStopped(f.PID) {
+		node.setPaused(true)
+	}
+	return true, nil
+}
+
+// killNodeProcess sends a SIGKILL to the process group of the
+// node process of [f], and waits for the process to exit
+func killNodeProcess(f pidFile) error {
+	if _, err := reapOrphan(f, false); err != nil {
+		return fmt.Errorf("couldn't kill process %d: %w", f.PID, err)
+	}
+	deadline := time.Now().Add(defaultStopGracePeriod)
+	for isProcessRunning(f.PID, f.StartTime) {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("process %d didn't exit once killed", f.PID)
+		}
+		time.Sleep(killedProcessPollFreq)
+	}
+	return nil
+}
+
+// adoptedProcess is a node process started by another network runner.
+// As it isn't a child of this process, it's polled to be waited for,
+// and its exit status is unknown. Its output is only redirected if it
+// goes to output logs.
+type adoptedProcess struct {
+	pid int
+	// In clock ticks since boot. 0 if unknown.
+	startTime uint64
+	// Closed once the process exits. Nil if its output isn't followed.
+	output *nodeOutput
+}
+
+// Adopted processes are already started
+func (p *adoptedProcess) Start() error {
+	return errors.New("adopted process can't be started")
+}
+
+func (p *adoptedProcess) Wait() error {
+	for isProcessRunning(p.pid, p.startTime) {
+		time.Sleep(adoptedProcessPollFreq)
+	}
+	p.closeOutput()
+	return errAdoptedProcessExited
+}
+
+func (p *adoptedProcess) closeOutput() {
+	if p.output != nil {
+		_ = p.output.Close()
+	}
+}
+
+func (p *adoptedProcess) Stop() error {
+	return signalProcessGroup(p.pid, syscall.SIGTERM)
+}
+
+func (p *adoptedProcess) Kill() error {
+	return signalProcessGroup(p.pid, syscall.SIGKILL)
+}
+
+func (p *adoptedProcess) Pause() error {
+	return signalProcessGroup(p.pid, syscall.SIGSTOP)
+}
+
+func (p *adoptedProcess) Resume() error {
+	return signalProcessGroup(p.pid, syscall.SIGCONT)
+}
+
+func (p *adoptedProcess) getPID() int {
+	return p.pid
+}
@@ -0,0 +1,225 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestResumeNetwork checks that the running nodes of a network whose
+// runner exited are adopted, and that the other ones are started again
+func TestResumeNetwork(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS != "linux" {
+		t.Skip("processes are told apart through /proc")
+	}
+	assert := assert.New(t)
+	rootDir := t.TempDir()
+	networkConfig := testNetworkConfig(t)
+
+	// a runner that exited
+	runner := exec.Command("true")
+	assert.NoError(runner.Run())
+	// and a node it left running, which keeps writing to its stdout
+	outputDir := filepath.Join(rootDir, "node0")
+	npc := &nodeProcessCreator{colorPicker: utils.NewColorPicker(), stdout: io.Discard}
+	orphan, err := npc.NewNodeProcess(node.Config{
+		BinaryPath:     "sh",
+		RedirectStdout: true,
+		OutputLogs:     node.OutputLogs{Dir: outputDir},
+	}, "-c", "while true; do echo output; sleep 0.01; done")
+	assert.NoError(err)
+	assert.NoError(orphan.Start())
+	orphanPID := orphan.(*nodeProcessImpl).cmd.Process.Pid
+	orphanExited := make(chan struct{})
+	go func() {
+		_ = orphan.Wait()
+		close(orphanExited)
+	}()
+	// the output of the runner is gone with it
+	orphan.(*nodeProcessImpl).closeOutput()
+	stdoutPath := filepath.Join(outputDir, node.StdoutLogFileName)
+	assertWriting := func() {
+		info, err := os.Stat(stdoutPath)
+		assert.NoError(err)
+		assert.Eventually(func() bool {
+			newInfo, err := os.Stat(stdoutPath)
+			return err == nil && newInfo.Size() > info.Size()
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+	assertWriting()
+	startTime, running, err := getProcessStartTime(orphanPID)
+	assert.NoError(err)
+	assert.True(running)
+	// paused by the runner
+	assert.NoError(signalProcessGroup(orphanPID, syscall.SIGSTOP))
+	assert.Eventually(func() bool {
+		return isProcessStopped(orphanPID)
+	}, 5*time.Second, 10*time.Millisecond)
+	apiPort, err := ports.allocate(network.PortRange{})
+	assert.NoError(err)
+	p2pPort, err := ports.allocate(network.PortRange{})
+	assert.NoError(err)
+	ports.release(apiPort, p2pPort)
+
+	ln := &localNetwork{rootDir: rootDir}
+	writePIDFile := func(f pidFile) {
+		b, err := json.Marshal(f)
+		assert.NoError(err)
+		assert.NoError(createFileAndWrite(ln.getPIDFilePath(f.NodeName), b))
+	}
+	writePIDFile(pidFile{
+		NodeName:  "node0",
+		PID:       orphanPID,
+		StartTime: startTime,
+		RunnerPID: runner.Process.Pid,
+		Args:      []string{"--http-port=1"},
+		Host:      "127.0.0.1",
+		IP:        net.IPv4(127, 0, 0, 1),
+		APIPort:   apiPort,
+		P2PPort:   p2pPort,
+	})
+	// a node that already exited
+	writePIDFile(pidFile{
+		NodeName:  "node1",
+		PID:       runner.Process.Pid,
+		RunnerPID: runner.Process.Pid,
+		Args:      []string{"--http-port=2"},
+	})
+
+	net, err := resumeNetwork(
+		logging.NoLog{},
+		networkConfig,
+		newMockAPISuccessful,
+		&localTestSuccessfulNodeProcessCreator{},
+		rootDir,
+		"",
+		nil,
+	)
+	assert.NoError(err)
+	nodes, err := net.GetAllNodes()
+	assert.NoError(err)
+	assert.Len(nodes, 3)
+	node0 := nodes["node0"].(*localNode)
+	process, ok := node0.getProcess().(*adoptedProcess)
+	assert.True(ok)
+	assert.Equal(orphanPID, process.getPID())
+	assert.Equal(apiPort, node0.GetAPIPort())
+	assert.Equal([]string{"--http-port=1"}, node0.args)
+	for _, name := range []string{"node1", "node2"} {
+		_, ok := nodes[name].(*localNode).getProcess().(*adoptedProcess)
+		assert.False(ok, name)
+	}
+	assert.True(node0.GetPaused())
+	assert.NoError(net.ResumeNode("node0"))
+	assert.Eventually(func() bool {
+		return !isProcessStopped(orphanPID)
+	}, 5*time.Second, 10*time.Millisecond)
+	assertWriting()
+	assert.NoError(awaitNetworkHealthy(net, 10*time.Second))
+	// now recorded as run by this runner
+	f, err := readPIDFile(ln.getPIDFilePath("node0"))
+	assert.NoError(err)
+	assert.Equal(orphanPID, f.PID)
+	assert.Equal(os.Getpid(), f.RunnerPID)
+
+	// the nodes of a running runner can't be adopted
+	_, err = resumeNetwork(
+		logging.NoLog{},
+		networkConfig,
+		newMockAPISuccessful,
+		&localTestSuccessfulNodeProcessCreator{},
+		rootDir,
+		"",
+		nil,
+	)
+	assert.Error(err)
+
+	assert.NoError(net.Stop(context.Background()))
+	select {
+	case <-orphanExited:
+	case <-time.After(10 * time.Second):
+		assert.Fail("adopted process not stopped")
+	}
+}
+
+// TestResumeNetworkP2PProxy checks that the running nodes of a network
+// with a P2P proxy are killed, and started again behind the proxy
+func TestResumeNetworkP2PProxy(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS != "linux" {
+		t.Skip("processes are told apart through /proc")
+	}
+	assert := assert.New(t)
+	rootDir := t.TempDir()
+	networkConfig := testNetworkConfig(t)
+	networkConfig.P2PProxy = true
+
+	// a runner that exited, and a node it left running
+	runner := exec.Command("true")
+	assert.NoError(runner.Run())
+	npc := &nodeProcessCreator{colorPicker: utils.NewColorPicker(), stdout: io.Discard, stderr: io.Discard}
+	orphan, err := npc.NewNodeProcess(node.Config{BinaryPath: "sleep"}, "60")
+	assert.NoError(err)
+	assert.NoError(orphan.Start())
+	orphanPID := orphan.(*nodeProcessImpl).cmd.Process.Pid
+	orphanExited := make(chan struct{})
+	go func() {
+		_ = orphan.Wait()
+		close(orphanExited)
+	}()
+	startTime, running, err := getProcessStartTime(orphanPID)
+	assert.NoError(err)
+	assert.True(running)
+
+	ln := &localNetwork{rootDir: rootDir}
+	b, err := json.Marshal(pidFile{
+		NodeName:  "node0",
+		PID:       orphanPID,
+		StartTime: startTime,
+		RunnerPID: runner.Process.Pid,
+		Args:      []string{"--http-port=1"},
+	})
+	assert.NoError(err)
+	assert.NoError(createFileAndWrite(ln.getPIDFilePath("node0"), b))
+
+	net, err := resumeNetwork(
+		logging.NoLog{},
+		networkConfig,
+		newMockAPISuccessful,
+		&localTestSuccessfulNodeProcessCreator{},
+		rootDir,
+		"",
+		nil,
+	)
+	assert.NoError(err)
+	defer func() {
+		_ = net.Stop(context.Background())
+	}()
+	select {
+	case <-orphanExited:
+	case <-time.After(10 * time.Second):
+		assert.Fail("node process not killed")
+	}
+	nodes, err := net.GetAllNodes()
+	assert.NoError(err)
+	assert.Len(nodes, 3)
+	for name, node := range nodes {
+		_, ok := node.(*localNode).getProcess().(*adoptedProcess)
+		assert.False(ok, name)
+	}
+}
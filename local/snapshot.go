@@ -0,0 +1,340 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ava-labs/avalanche-network-runner/api"
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/utils/beacon"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+const (
+	// File in the snapshot dir holding the network config
+	snapshotNetworkConfigFileName = "network.json"
+	// Subdir of a node's snapshot dir holding its database
+	snapshotDBSubDir = "db"
+)
+
+var errSnapshotNotFound = errors.New("snapshot not found")
+
+// NewNetworkFromSnapshot returns a new network started from the snapshot
+// with name [snapshotName], found in [snapshotsDir].
+// See NewNetwork for the meaning of [rootDir] and [snapshotsDir].
+// If len([binaryPath]) != 0, it is used for all the nodes instead of
+// the binary path saved in the snapshot.
+// See NewNetwork for the meaning of [events].
+func NewNetworkFromSnapshot(
+	log logging.Logger,
+	snapshotName string,
+	rootDir string,
+	snapshotsDir string,
+	binaryPath string,
+	events *network.EventBus,
+) (network.Network, error) {
+	return newNetworkFromSnapshot(
+		log,
+		snapshotName,
+		api.NewAPIClient,
+		&nodeProcessCreator{
+			colorPicker: utils.NewColorPicker(),
+			stdout:      os.Stdout,
+			stderr:      os.Stderr,
+		},
+		rootDir,
+		snapshotsDir,
+		binaryPath,
+		events,
+	)
+}
+
+// See NewNetworkFromSnapshot.
+func newNetworkFromSnapshot(
+	log logging.Logger,
+	snapshotName string,
+	newAPIClientF api.NewAPIClientF,
+	nodeProcessCreator NodeProcessCreator,
+	rootDir string,
+	snapshotsDir string,
+	binaryPath string,
+	events *network.EventBus,
+) (network.Network, error) {
+	net, err := newLocalNetwork(log, newAPIClientF, nodeProcessCreator, rootDir, snapshotsDir, events)
+	if err != nil {
+		return nil, err
+	}
+	if err := net.loadSnapshot(context.Background(), snapshotName, binaryPath); err != nil {
+		return nil, err
+	}
+	return net, nil
+}
+
+// GetSnapshotNames returns the names of the snapshots found in [snapshotsDir].
+// Returns an empty list if [snapshotsDir] doesn't exist.
+func GetSnapshotNames(snapshotsDir string) ([]string, error) {
+	entries, err := os.ReadDir(snapshotsDir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	snapshotNames := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		// Ignore dirs that don't look like a snapshot
+		networkConfigPath := filepath.Join(snapshotsDir, entry.Name(), snapshotNetworkConfigFileName)
+		if _, err := os.Stat(networkConfigPath); err != nil {
+			continue
+		}
+		snapshotNames = append(snapshotNames, entry.Name())
+	}
+	return snapshotNames, nil
+}
+
+// RemoveSnapshot removes the snapshot with name [snapshotName] from [snapshotsDir].
+func RemoveSnapshot(snapshotsDir string, snapshotName string) error {
+	snapshotDir, err := getSnapshotDir(snapshotsDir, snapshotName)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(filepath.Join(snapshotDir, snapshotNetworkConfigFileName)); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("%w: %q", errSnapshotNotFound, snapshotName)
+		}
+		return err
+	}
+	return os.RemoveAll(snapshotDir)
+}
+
+// See network.Network
+func (ln *localNetwork) SaveSnapshot(ctx context.Context, snapshotName string) (string, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.isStopped() {
+		return "", network.ErrStopped
+	}
+	snapshotDir, err := getSnapshotDir(ln.snapshotsDir, snapshotName)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(snapshotDir); err == nil {
+		return "", fmt.Errorf("snapshot %q already exists", snapshotName)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+
+	// Keep the node configs and db dirs, as they are lost when the nodes are stopped
+	networkConfig := network.Config{
+		Genesis:         string(ln.genesis),
+		Flags:           ln.flags,
+		PortRange:       ln.portRange,
+		P2PProxy:        ln.p2pProxy,
+		DistinctNodeIPs: ln.distinctNodeIPs,
+		StopGracePeriod: ln.stopGracePeriod,
+	}
+	nodeNames := make([]string, 0, len(ln.nodes))
+	for nodeName := range ln.nodes {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	sort.Strings(nodeNames)
+	nodeDBDirs := make(map[string]string, len(ln.nodes))
+	for _, nodeName := range nodeNames {
+		node := ln.nodes[nodeName]
+		networkConfig.NodeConfigs = append(networkConfig.NodeConfigs, node.config)
+		nodeDBDirs[nodeName] = node.dbDir
+	}
+
+	// The databases can only be safely copied once the nodes are stopped
+	ln.log.Info("stopping network to save snapshot %q", snapshotName)
+	if err := ln.stop(ctx); err != nil {
+		return "", fmt.Errorf("couldn't stop network to save snapshot: %w", err)
+	}
+
+	// avalanchego keeps the database of each network under a subdir named after it
+	networkName := constants.NetworkName(ln.networkID)
+	for nodeName, dbDir := range nodeDBDirs {
+		src := filepath.Join(dbDir, networkName)
+		if _, err := os.Stat(src); errors.Is(err, fs.ErrNotExist) {
+			ln.log.Debug("node %q has no database at %s", nodeName, src)
+			continue
+		}
+		dst := filepath.Join(snapshotDir, nodeName, snapshotDBSubDir, networkName)
+		if err := copyDir(src, dst); err != nil {
+			return "", fmt.Errorf("couldn't copy database of node %q: %w", nodeName, err)
+		}
+	}
+	networkConfigJSON, err := json.MarshalIndent(networkConfig, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	// Written last so a partially written snapshot isn't listed
+	networkConfigPath := filepath.Join(snapshotDir, snapshotNetworkConfigFileName)
+	if err := createFileAndWrite(networkConfigPath, networkConfigJSON); err != nil {
+		return "", fmt.Errorf("couldn't write file at %q: %w", networkConfigPath, err)
+	}
+	ln.log.Info("saved snapshot %q at %s", snapshotName, snapshotDir)
+	return snapshotDir, nil
+}
+
+// See network.Network
+func (ln *localNetwork) LoadSnapshot(ctx context.Context, snapshotName string) error {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if !ln.isStopped() {
+		if err := ln.stop(ctx); err != nil {
+			return fmt.Errorf("couldn't stop network to load snapshot: %w", err)
+		}
+	}
+	return ln.loadSnapshot(ctx, snapshotName, "")
+}
+
+// See network.Network
+func (ln *localNetwork) RemoveSnapshot(snapshotName string) error {
+	return RemoveSnapshot(ln.snapshotsDir, snapshotName)
+}
+
+// See network.Network
+func (ln *localNetwork) GetSnapshotNames() ([]string, error) {
+	return GetSnapshotNames(ln.snapshotsDir)
+}
+
+// loadSnapshot starts the nodes saved in snapshot [snapshotName].
+// Each node database is restored under [ln.rootDir], and the node
+// is told to keep its database and logs there, regardless of the
+// dirs given in its config.
+// If len([binaryPath]) != 0, it replaces the binary path of all nodes.
+// Assumes [ln.lock] is held, or that [ln] isn't accessible to other
+// goroutines yet, and that [ln] has no running nodes.
+func (ln *localNetwork) loadSnapshot(ctx context.Context, snapshotName string, binaryPath string) error {
+	snapshotDir, err := getSnapshotDir(ln.snapshotsDir, snapshotName)
+	if err != nil {
+		return err
+	}
+	networkConfigJSON, err := os.ReadFile(filepath.Join(snapshotDir, snapshotNetworkConfigFileName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("%w: %q", errSnapshotNotFound, snapshotName)
+		}
+		return fmt.Errorf("couldn't read snapshot %q: %w", snapshotName, err)
+	}
+	var networkConfig network.Config
+	if err := json.Unmarshal(networkConfigJSON, &networkConfig); err != nil {
+		return fmt.Errorf("couldn't unmarshal snapshot %q: %w", snapshotName, err)
+	}
+	for i := range networkConfig.NodeConfigs {
+		nodeConfig := &networkConfig.NodeConfigs[i]
+		nodeDir := filepath.Join(ln.rootDir, nodeConfig.Name)
+		src := filepath.Join(snapshotDir, nodeConfig.Name, snapshotDBSubDir)
+		if _, err := os.Stat(src); err == nil {
+			if err := os.MkdirAll(nodeDir, 0o755); err != nil {
+				return err
+			}
+			if err := removeDirContents(src, nodeDir); err != nil {
+				return fmt.Errorf("couldn't remove previous database of node %q: %w", nodeConfig.Name, err)
+			}
+			if err := copyDir(src, nodeDir); err != nil {
+				return fmt.Errorf("couldn't restore database of node %q: %w", nodeConfig.Name, err)
+			}
+		}
+		if nodeConfig.Flags == nil {
+			nodeConfig.Flags = map[string]interface{}{}
+		}
+		nodeConfig.Flags[config.DBPathKey] = nodeDir
+		nodeConfig.Flags[config.LogsDirKey] = filepath.Join(nodeDir, logsSubDir)
+		nodeConfig.OutputLogs.Dir = nodeDir
+		if len(binaryPath) != 0 {
+			nodeConfig.BinaryPath = binaryPath
+		}
+	}
+
+	ln.log.Info("loading snapshot %q from %s", snapshotName, snapshotDir)
+	ln.nodes = map[string]*localNode{}
+	ln.bootstraps = beacon.NewSet()
+	ln.partition = nil
+	ln.nodeFaults = nil
+	ln.linkFaults = nil
+	ln.closedOnStopCh = make(chan struct{})
+	return ln.loadConfig(ctx, networkConfig)
+}
+
+// getSnapshotDir returns the dir of snapshot [snapshotName] in [snapshotsDir].
+// Returns an error if [snapshotName] isn't a valid snapshot name.
+func getSnapshotDir(snapshotsDir string, snapshotName string) (string, error) {
+	if snapshotName == "" || snapshotName == "." || snapshotName == ".." || filepath.Base(snapshotName) != snapshotName {
+		return "", fmt.Errorf("invalid snapshot name %q", snapshotName)
+	}
+	return filepath.Join(snapshotsDir, snapshotName), nil
+}
+
+// removeDirContents removes from [dst] every entry
+// that is also present in [src].
+func removeDirContents(src string, dst string) error {
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(dst, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyDir recursively copies the dirs and regular files
+// of [src] into [dst], creating [dst] if needed.
+func copyDir(src string, dst string) error {
+	return filepath.Walk(src, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		dstPath := filepath.Join(dst, relPath)
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(dstPath, info.Mode().Perm())
+		case info.Mode().IsRegular():
+			return copyFile(path, dstPath, info.Mode().Perm())
+		default:
+			// Skip sockets, symlinks, etc.
+			return nil
+		}
+	})
+}
+
+// copyFile copies the regular file at [src] to [dst] with permissions [perm].
+func copyFile(src string, dst string, perm fs.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
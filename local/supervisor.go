@@ -0,0 +1,206 @@
+package local
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"syscall"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+)
+
+const (
+	// Time to wait before restarting a node if its
+	// restart policy doesn't give one
+	defaultRestartBackoff = time.Second
+	// Max time to wait before restarting a node
+	maxRestartBackoff = time.Minute
+	// Max times a node is restarted on fresh ports
+	// when its ports are bound by another process
+	maxPortRetries = 3
+	// Time a node is given to exit once sent a SIGTERM, before it's
+	// sent a SIGKILL, if the network config doesn't give one
+	defaultStopGracePeriod = 10 * time.Second
+)
+
+// superviseNode waits for the process of [node] to exit.
+// If the exit wasn't asked for by removeNode, it's recorded as a crash and
+// the process is restarted with [node.args] according to the restart policy
+// of the node. Returns when the node is stopped or won't be restarted,
+// closing [node.supervisorDoneCh].
+// If the process failed because another process bound one of the ports
+// allocated to [node], the node is re-added on fresh ports instead.
+// Must not grab [ln.lock], as removeNode waits for it while holding [ln.lock].
+func (ln *localNetwork) superviseNode(node *localNode) {
+	defer close(node.supervisorDoneCh)
+
+	policy := node.config.RestartPolicy
+	for {
+		process := node.getProcess()
+		waitErr := process.Wait()
+		ln.removePIDFile(node.name, process)
+		exitCode, exitSignal := getExitStatus(waitErr)
+		portConflict := waitErr != nil && anyPortBound(node.allocatedPorts)
+
+		node.lock.Lock()
+		node.exitErr = waitErr
+		node.processStatus.Running = false
+		node.processStatus.ExitCode = exitCode
+		node.processStatus.ExitSignal = exitSignal
+		node.processStatus.ExitTime = time.Now()
+		stopping := node.stopping
+		restarts := node.processStatus.Restarts
+		restart := false
+		if !stopping {
+			node.processStatus.Crashes++
+			restart = portConflict || shouldRestart(policy, waitErr, restarts)
+			node.processStatus.Restarting = restart
+		}
+		node.lock.Unlock()
+
+		ln.events.Publish(network.NewEvent(
+			network.EventNodeExited,
+			node.name,
+			fmt.Sprintf("exit code %d, signal %q: %v", exitCode, exitSignal, waitErr),
+		))
+		if stopping {
+			return
+		}
+		ln.log.Warn("node %q exited unexpectedly (exit code %d, signal %q): %v", node.name, exitCode, exitSignal, waitErr)
+		if portConflict {
+			// Re-adding the node needs [ln.lock]
+			go ln.restartOnFreshPorts(node)
+			return
+		}
+		if !restart {
+			ln.log.Warn("node %q won't be restarted", node.name)
+			return
+		}
+
+		backoff := getRestartBackoff(policy.Backoff, restarts)
+		ln.log.Info("restarting node %q in %s", node.name, backoff)
+		select {
+		case <-node.stopCh:
+			node.lock.Lock()
+			node.processStatus.Restarting = false
+			node.lock.Unlock()
+			return
+		case <-time.After(backoff):
+		}
+
+		restarted, err := ln.restartNodeProcess(node)
+		if err != nil {
+			ln.log.Error("couldn't restart node %q: %s", node.name, err)
+			return
+		}
+		if !restarted {
+			return
+		}
+		ln.events.Publish(network.NewEvent(network.EventNodeStarted, node.name, "restarted by its restart policy"))
+	}
+}
+
+// restartNodeProcess starts a new process for [node] with
+// the args of the previous one, unless [node] is stopping.
+// Returns true if the process was restarted.
+func (ln *localNetwork) restartNodeProcess(node *localNode) (bool, error) {
+	node.lock.Lock()
+	defer node.lock.Unlock()
+
+	node.processStatus.Restarting = false
+	if node.stopping {
+		return false, nil
+	}
+	process, err := ln.nodeProcessCreator.NewNodeProcess(node.config, node.args...)
+	if err != nil {
+		return false, err
+	}
+	if err := process.Start(); err != nil {
+		return false, err
+	}
+	node.process = process
+	node.paused = false
+	node.processStatus.Running = true
+	node.processStatus.Restarts++
+	ln.writePIDFile(node, process)
+	return true, nil
+}
+
+// restartOnFreshPorts removes [node], whose process exited because one
+// of the ports allocated to it is bound by another process, and adds it
+// again with the same config, so it gets newly allocated ports.
+// Does nothing if [node] was removed meanwhile, or after [maxPortRetries].
+func (ln *localNetwork) restartOnFreshPorts(node *localNode) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.isStopped() || ln.nodes[node.name] != node {
+		return
+	}
+	if node.portRetries >= maxPortRetries {
+		ln.log.Warn("node %q won't be restarted: its ports were taken %d times", node.name, node.portRetries+1)
+		node.lock.Lock()
+		node.processStatus.Restarting = false
+		node.lock.Unlock()
+		return
+	}
+	ln.log.Info("restarting node %q on fresh ports, as one of %v is already bound", node.name, node.allocatedPorts)
+	// Fails with the exit error of the process, which is expected
+	_ = ln.removeNode(node.name)
+	newNode, err := ln.addNode(node.config)
+	if err != nil {
+		ln.log.Error("couldn't restart node %q on fresh ports: %s", node.name, err)
+		return
+	}
+	newNode.(*localNode).portRetries = node.portRetries + 1
+}
+
+// shouldRestart returns true if a process that exited with [waitErr],
+// after being restarted [restarts] times, must be restarted under [policy].
+func shouldRestart(policy node.RestartPolicy, waitErr error, restarts uint32) bool {
+	switch policy.Mode {
+	case node.RestartAlways:
+		return true
+	case node.RestartOnFailure:
+		return waitErr != nil && (policy.MaxRetries == 0 || restarts < policy.MaxRetries)
+	default:
+		return false
+	}
+}
+
+// getRestartBackoff returns the time to wait before a restart,
+// doubling [backoff] for each of the [restarts] already done.
+func getRestartBackoff(backoff time.Duration, restarts uint32) time.Duration {
+	if backoff == 0 {
+		backoff = defaultRestartBackoff
+	}
+	for i := uint32(0); i < restarts && backoff < maxRestartBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRestartBackoff {
+		backoff = maxRestartBackoff
+	}
+	return backoff
+}
+
+// getExitStatus returns the exit code and the name of the terminating
+// signal, if any, of a process whose Wait returned [waitErr].
+func getExitStatus(waitErr error) (int, string) {
+	if waitErr == nil {
+		return 0, ""
+	}
+	var remoteExitErr *ExitError
+	if errors.As(waitErr, &remoteExitErr) {
+		return remoteExitErr.ExitCode, remoteExitErr.ExitSignal
+	}
+	var exitErr *exec.ExitError
+	if !errors.As(waitErr, &exitErr) {
+		return -1, ""
+	}
+	if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return -1, status.Signal().String()
+	}
+	return exitErr.ExitCode(), ""
+}
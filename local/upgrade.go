@@ -0,0 +1,188 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanchego/config"
+)
+
+// See network.Network
+func (ln *localNetwork) RollingUpgrade(ctx context.Context, binaryPath string, opts network.UpgradeOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	batchSize := opts.BatchSize
+	if batchSize == 0 {
+		batchSize = 1
+	}
+	healthTimeout := opts.HealthTimeout
+	if healthTimeout == 0 {
+		healthTimeout = network.DefaultUpgradeHealthTimeout
+	}
+	nodeNames, err := ln.getUpgradeNodeNames(opts.NodeNames)
+	if err != nil {
+		return err
+	}
+
+	// Node name --> config it had before the upgrade
+	previousConfigs := make(map[string]node.Config, len(nodeNames))
+	upgraded := make([]string, 0, len(nodeNames))
+	for start := 0; start < len(nodeNames); start += batchSize {
+		end := start + batchSize
+		if end > len(nodeNames) {
+			end = len(nodeNames)
+		}
+		batch := nodeNames[start:end]
+		ln.log.Info("upgrading nodes %v to %s", batch, binaryPath)
+
+		var batchErr error
+		for _, nodeName := range batch {
+			previousConfig, err := ln.upgradeNode(nodeName, binaryPath)
+			if err == network.ErrStopped {
+				return err
+			}
+			if previousConfig.Name != "" {
+				previousConfigs[nodeName] = previousConfig
+				upgraded = append(upgraded, nodeName)
+			}
+			if err != nil {
+				batchErr = fmt.Errorf("couldn't restart node %q: %w", nodeName, err)
+				break
+			}
+		}
+		if batchErr == nil {
+			healthCtx, cancel := context.WithTimeout(ctx, healthTimeout)
+			batchErr = <-ln.WaitForNodes(healthCtx, batch...)
+			cancel()
+		}
+		if batchErr == nil {
+			continue
+		}
+
+		upgradeErr := &network.UpgradeError{
+			Upgraded:    upgraded,
+			FailedBatch: batch,
+			Err:         batchErr,
+		}
+		if !opts.Rollback {
+			ln.log.Warn("aborting upgrade: %s", upgradeErr)
+			return upgradeErr
+		}
+		ln.log.Warn("rolling back upgrade of nodes %v: %s", upgraded, batchErr)
+		if err := ln.rollBack(ctx, upgraded, previousConfigs, healthTimeout); err != nil {
+			upgradeErr.RollbackErr = err
+		} else {
+			upgradeErr.RolledBack = true
+		}
+		return upgradeErr
+	}
+	ln.log.Info("upgraded nodes %v to %s", nodeNames, binaryPath)
+	return nil
+}
+
+// getUpgradeNodeNames returns [nodeNames] if all of them are nodes of
+// the network, or the names of all the nodes, sorted, if it's empty
+func (ln *localNetwork) getUpgradeNodeNames(nodeNames []string) ([]string, error) {
+	ln.lock.RLock()
+	defer ln.lock.RUnlock()
+
+	if ln.isStopped() {
+		return nil, network.ErrStopped
+	}
+	if len(nodeNames) == 0 {
+		nodeNames = make([]string, 0, len(ln.nodes))
+		for nodeName := range ln.nodes {
+			nodeNames = append(nodeNames, nodeName)
+		}
+		sort.Strings(nodeNames)
+		return nodeNames, nil
+	}
+	for _, nodeName := range nodeNames {
+		if _, ok := ln.nodes[nodeName]; !ok {
+			return nil, fmt.Errorf("node %q not found", nodeName)
+		}
+	}
+	return nodeNames, nil
+}
+
+// upgradeNode restarts node [nodeName] onto the binary at [binaryPath],
+// on the same ports. Returns the config the node had, with its ports,
+// which is empty if the node wasn't stopped.
+func (ln *localNetwork) upgradeNode(nodeName string, binaryPath string) (node.Config, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	if ln.isStopped() {
+		return node.Config{}, network.ErrStopped
+	}
+	localNode, ok := ln.nodes[nodeName]
+	if !ok {
+		return node.Config{}, fmt.Errorf("node %q not found", nodeName)
+	}
+	// The other nodes know the node by its P2P port,
+	// and users by its API port
+	previousConfig := localNode.config
+	previousConfig.Flags = make(map[string]interface{}, len(localNode.config.Flags)+2)
+	for flagName, flagVal := range localNode.config.Flags {
+		previousConfig.Flags[flagName] = flagVal
+	}
+	previousConfig.Flags[config.HTTPPortKey] = int(localNode.apiPort)
+	previousConfig.Flags[config.StakingPortKey] = int(localNode.p2pPort)
+
+	nodeConfig := previousConfig
+	nodeConfig.BinaryPath = binaryPath
+	if err := ln.replaceNode(nodeName, nodeConfig); err != nil {
+		if _, ok := ln.nodes[nodeName]; ok {
+			// Still running the previous binary
+			return node.Config{}, err
+		}
+		return previousConfig, err
+	}
+	return previousConfig, nil
+}
+
+// rollBack restarts nodes [nodeNames] with their config in [previousConfigs],
+// and waits up to [healthTimeout] for them to become healthy
+func (ln *localNetwork) rollBack(
+	ctx context.Context,
+	nodeNames []string,
+	previousConfigs map[string]node.Config,
+	healthTimeout time.Duration,
+) error {
+	for _, nodeName := range nodeNames {
+		ln.lock.Lock()
+		err := network.ErrStopped
+		if !ln.isStopped() {
+			err = ln.replaceNode(nodeName, previousConfigs[nodeName])
+		}
+		ln.lock.Unlock()
+		if err != nil {
+			return fmt.Errorf("couldn't restart node %q: %w", nodeName, err)
+		}
+	}
+	healthCtx, cancel := context.WithTimeout(ctx, healthTimeout)
+	defer cancel()
+	return <-ln.WaitForNodes(healthCtx, nodeNames...)
+}
+
+// replaceNode stops node [nodeName], if it's in the network,
+// and starts it again with [nodeConfig]
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) replaceNode(nodeName string, nodeConfig node.Config) error {
+	if _, ok := ln.nodes[nodeName]; ok {
+		if err := ln.removeNode(nodeName); err != nil {
+			if _, ok := ln.nodes[nodeName]; ok {
+				return err
+			}
+			// Removed, but its process had failed
+			ln.log.Warn("node %q stopped with an error before being restarted: %s", nodeName, err)
+		}
+	}
+	_, err := ln.addNode(nodeConfig)
+	return err
+}
@@ -0,0 +1,201 @@
+package network
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// Key of the C-Chain genesis in the avalanchego genesis
+const cChainGenesisKey = "cChainGenesis"
+
+var errNoDeployedBytecode = errors.New("artifact has no deployed bytecode")
+
+// CChainAllocation is an account of the C-Chain from the genesis:
+// an address with AVAX, or a contract deployed at block 0
+type CChainAllocation struct {
+	// Hex address, e.g. 0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC
+	Address string `json:"address"`
+	// Balance, in wei. Nil for none.
+	Balance *big.Int `json:"balance"`
+	// Runtime bytecode of the contract at the address, if any.
+	// Constructors don't run, so their effects must be set in Storage.
+	Code hexutil.Bytes `json:"code,omitempty"`
+	// Storage of the contract at the address
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+	Nonce   uint64                      `json:"nonce,omitempty"`
+}
+
+// Validate returns an error if this allocation is invalid
+func (a CChainAllocation) Validate() error {
+	if !common.IsHexAddress(a.Address) {
+		return fmt.Errorf("invalid C-Chain address %q", a.Address)
+	}
+	if a.Balance != nil && a.Balance.Sign() < 0 {
+		return fmt.Errorf("negative balance of C-Chain address %s", a.Address)
+	}
+	return nil
+}
+
+// cChainAccount is an account in the alloc of a C-Chain genesis,
+// which has the format of the alloc of a geth genesis
+type cChainAccount struct {
+	Balance *math.HexOrDecimal256       `json:"balance"`
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+	Nonce   math.HexOrDecimal64         `json:"nonce,omitempty"`
+}
+
+func (a CChainAllocation) toAccount() cChainAccount {
+	balance := new(big.Int)
+	if a.Balance != nil {
+		balance.Set(a.Balance)
+	}
+	return cChainAccount{
+		Balance: (*math.HexOrDecimal256)(balance),
+		Code:    a.Code,
+		Storage: a.Storage,
+		Nonce:   math.HexOrDecimal64(a.Nonce),
+	}
+}
+
+// ParseCChainAllocations returns the allocations of [allocBytes], a JSON
+// in the format of the alloc of a geth genesis, from address to account
+// with balance, code, storage and nonce. They are sorted by address.
+func ParseCChainAllocations(allocBytes []byte) ([]CChainAllocation, error) {
+	var alloc map[string]cChainAccount
+	if err := json.Unmarshal(allocBytes, &alloc); err != nil {
+		return nil, fmt.Errorf("couldn't unmarshal C-Chain alloc: %w", err)
+	}
+	allocations := make([]CChainAllocation, 0, len(alloc))
+	for addr, account := range alloc {
+		if !strings.HasPrefix(addr, "0x") {
+			addr = "0x" + addr
+		}
+		allocation := CChainAllocation{
+			Address: addr,
+			Code:    account.Code,
+			Storage: account.Storage,
+			Nonce:   uint64(account.Nonce),
+		}
+		if account.Balance != nil {
+			allocation.Balance = (*big.Int)(account.Balance)
+		}
+		if err := allocation.Validate(); err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, allocation)
+	}
+	sort.Slice(allocations, func(i, j int) bool {
+		return allocations[i].Address < allocations[j].Address
+	})
+	return allocations, nil
+}
+
+// MarshalCChainAllocations returns [allocations] as a JSON in
+// the format of the alloc of a geth genesis. See ParseCChainAllocations.
+func MarshalCChainAllocations(allocations []CChainAllocation) ([]byte, error) {
+	for _, allocation := range allocations {
+		if err := allocation.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(addToAlloc(nil, allocations))
+}
+
+// LoadCChainAllocations returns the allocations of the file at [path].
+// See ParseCChainAllocations.
+func LoadCChainAllocations(path string) ([]CChainAllocation, error) {
+	allocBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCChainAllocations(allocBytes)
+}
+
+// LoadCChainArtifact returns an allocation deploying at [address] the
+// contract of the foundry or hardhat artifact at [path], without storage
+func LoadCChainArtifact(path string, address string) (CChainAllocation, error) {
+	artifactBytes, err := os.ReadFile(path)
+	if err != nil {
+		return CChainAllocation{}, err
+	}
+	var artifact struct {
+		DeployedBytecode json.RawMessage `json:"deployedBytecode"`
+	}
+	if err := json.Unmarshal(artifactBytes, &artifact); err != nil {
+		return CChainAllocation{}, fmt.Errorf("couldn't unmarshal artifact %q: %w", path, err)
+	}
+	// Hardhat gives the bytecode, and foundry an object with it
+	var code hexutil.Bytes
+	if err := json.Unmarshal(artifact.DeployedBytecode, &code); err != nil {
+		var bytecode struct {
+			Object hexutil.Bytes `json:"object"`
+		}
+		if err := json.Unmarshal(artifact.DeployedBytecode, &bytecode); err != nil {
+			return CChainAllocation{}, fmt.Errorf("couldn't unmarshal deployed bytecode of artifact %q: %w", path, err)
+		}
+		code = bytecode.Object
+	}
+	if len(code) == 0 {
+		return CChainAllocation{}, fmt.Errorf("%w: %q", errNoDeployedBytecode, path)
+	}
+	allocation := CChainAllocation{
+		Address: address,
+		Code:    code,
+	}
+	return allocation, allocation.Validate()
+}
+
+// AddCChainAllocations returns avalanchego genesis [genesis], with
+// [allocations] added to the alloc of its C-Chain genesis. They replace
+// the accounts of the same addresses.
+func AddCChainAllocations(genesis []byte, allocations []CChainAllocation) ([]byte, error) {
+	var genesisMap map[string]interface{}
+	if err := json.Unmarshal(genesis, &genesisMap); err != nil {
+		return nil, fmt.Errorf("couldn't unmarshal genesis: %w", err)
+	}
+	cChainGenesisStr, ok := genesisMap[cChainGenesisKey].(string)
+	if !ok {
+		return nil, fmt.Errorf("genesis has no %s string", cChainGenesisKey)
+	}
+	var cChainGenesis map[string]interface{}
+	if err := json.Unmarshal([]byte(cChainGenesisStr), &cChainGenesis); err != nil {
+		return nil, fmt.Errorf("couldn't unmarshal C-Chain genesis: %w", err)
+	}
+	cChainGenesis["alloc"] = addToAlloc(cChainGenesis["alloc"], allocations)
+	cChainGenesisBytes, err := json.Marshal(cChainGenesis)
+	if err != nil {
+		return nil, err
+	}
+	genesisMap[cChainGenesisKey] = string(cChainGenesisBytes)
+	return json.Marshal(genesisMap)
+}
+
+// addToAlloc returns the accounts of [alloc], a C-Chain genesis alloc that
+// may be nil, with the accounts of [allocations] replacing those of the
+// same addresses, whatever their case or 0x prefix
+func addToAlloc(alloc interface{}, allocations []CChainAllocation) map[string]interface{} {
+	accounts, _ := alloc.(map[string]interface{})
+	newAccounts := make(map[string]interface{}, len(accounts)+len(allocations))
+	for addr, account := range accounts {
+		newAccounts[addr] = account
+	}
+	for _, allocation := range allocations {
+		for addr := range newAccounts {
+			if common.HexToAddress(addr) == common.HexToAddress(allocation.Address) {
+				delete(newAccounts, addr)
+			}
+		}
+		newAccounts[allocation.Address] = allocation.toAccount()
+	}
+	return newAccounts
+}
@@ -0,0 +1,87 @@
+package network_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCChainAllocations(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	dir := t.TempDir()
+
+	const (
+		tokenAddr = "0x0100000000000000000000000000000000000000"
+		userAddr  = "0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC"
+	)
+
+	// Allocations in the format of the alloc of a geth genesis
+	allocPath := filepath.Join(dir, "alloc.json")
+	assert.NoError(os.WriteFile(allocPath, []byte(`{
+		"0100000000000000000000000000000000000000": {
+			"balance": "0x0",
+			"code": "0x6080",
+			"storage": {"0x0000000000000000000000000000000000000000000000000000000000000002": "0x00000000000000000000000000000000000000000000000000000000000003e8"},
+			"nonce": "0x1"
+		},
+		"0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC": {"balance": "1000"}
+	}`), 0o600))
+	allocations, err := network.LoadCChainAllocations(allocPath)
+	assert.NoError(err)
+	assert.Len(allocations, 2)
+	token := allocations[0]
+	assert.Equal(tokenAddr, token.Address)
+	assert.EqualValues([]byte{0x60, 0x80}, token.Code)
+	assert.Equal(common.HexToHash("0x3e8"), token.Storage[common.HexToHash("0x2")])
+	assert.EqualValues(1, token.Nonce)
+	assert.Equal(userAddr, allocations[1].Address)
+	assert.Equal(big.NewInt(1000), allocations[1].Balance)
+	allocBytes, err := network.MarshalCChainAllocations(allocations)
+	assert.NoError(err)
+	parsedAllocations, err := network.ParseCChainAllocations(allocBytes)
+	assert.NoError(err)
+	assert.Equal(allocations, parsedAllocations)
+	_, err = network.ParseCChainAllocations([]byte(`{"0x1234": {"balance": "0x1"}}`))
+	assert.Error(err)
+
+	// Artifacts of foundry and hardhat
+	foundryPath := filepath.Join(dir, "foundry.json")
+	assert.NoError(os.WriteFile(foundryPath, []byte(`{"abi":[],"deployedBytecode":{"object":"0x6001"}}`), 0o600))
+	allocation, err := network.LoadCChainArtifact(foundryPath, tokenAddr)
+	assert.NoError(err)
+	assert.EqualValues([]byte{0x60, 0x01}, allocation.Code)
+	hardhatPath := filepath.Join(dir, "hardhat.json")
+	assert.NoError(os.WriteFile(hardhatPath, []byte(`{"abi":[],"deployedBytecode":"0x6002"}`), 0o600))
+	allocation, err = network.LoadCChainArtifact(hardhatPath, tokenAddr)
+	assert.NoError(err)
+	assert.EqualValues([]byte{0x60, 0x02}, allocation.Code)
+	emptyPath := filepath.Join(dir, "empty.json")
+	assert.NoError(os.WriteFile(emptyPath, []byte(`{"abi":[],"deployedBytecode":"0x"}`), 0o600))
+	_, err = network.LoadCChainArtifact(emptyPath, tokenAddr)
+	assert.Error(err)
+
+	// Allocations are added to the C-Chain genesis,
+	// replacing the accounts of the same addresses
+	genesis := []byte(`{"networkID":1337,"cChainGenesis":"{\"alloc\":{\"8db97c7cece249c2b98bdc0226cc4c2a57bf52fc\":{\"balance\":\"0x1\"},\"0200000000000000000000000000000000000000\":{\"balance\":\"0x2\"}}}"}`)
+	genesis, err = network.AddCChainAllocations(genesis, allocations)
+	assert.NoError(err)
+	var genesisMap map[string]interface{}
+	assert.NoError(json.Unmarshal(genesis, &genesisMap))
+	assert.EqualValues(1337, genesisMap["networkID"])
+	var cChainGenesis struct {
+		Alloc map[string]map[string]interface{} `json:"alloc"`
+	}
+	assert.NoError(json.Unmarshal([]byte(genesisMap["cChainGenesis"].(string)), &cChainGenesis))
+	assert.Len(cChainGenesis.Alloc, 3)
+	assert.Equal("0x2", cChainGenesis.Alloc["0200000000000000000000000000000000000000"]["balance"])
+	assert.Equal("0x3e8", cChainGenesis.Alloc[userAddr]["balance"])
+	assert.Equal("0x6080", cChainGenesis.Alloc[tokenAddr]["code"])
+	assert.Equal("0x1", cChainGenesis.Alloc[tokenAddr]["nonce"])
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"strconv"
 	"time"
 
@@ -59,6 +60,48 @@ type Config struct {
 	// and the node's config file has flag W set to Z,
 	// then the node will be started with flag W set to Y.
 	Flags map[string]interface{} `json:"flags"`
+	// Ports of the nodes not given in their config are taken from this
+	// range, in increasing order, so they are the same on each run if free.
+	// If it's the zero value, random ports are used.
+	PortRange PortRange `json:"portRange"`
+	// If true, nodes reach each other through a proxy in front of
+	// each node's P2P port, so the network can be partitioned.
+	P2PProxy bool `json:"p2pProxy"`
+	// If true, each node gets its own loopback IP, 127.0.1.N, as its
+	// public IP, HTTP host and bootstrap IP, instead of 127.0.0.1, so
+	// the nodes look like different hosts to each other. On hosts that
+	// only route 127.0.0.1 to the loopback interface, e.g. macOS, the
+	// IPs must be added to it first.
+	DistinctNodeIPs bool `json:"distinctNodeIPs"`
+	// Accounts added to the C-Chain genesis of Genesis, e.g. to deploy
+	// contracts at block 0. They replace the accounts of the same addresses.
+	CChainAllocations []CChainAllocation `json:"cChainAllocations"`
+	// Time a node is given to exit once sent a SIGTERM when it's
+	// removed or the network is stopped, before it's sent a SIGKILL.
+	// If 0, a default is used.
+	StopGracePeriod time.Duration `json:"stopGracePeriod"`
+}
+
+// PortRange is a range of ports, bounds included
+type PortRange struct {
+	Min uint16 `json:"min"`
+	Max uint16 `json:"max"`
+}
+
+// IsZero returns true if this is the zero value, meaning no range
+func (r PortRange) IsZero() bool {
+	return r == PortRange{}
+}
+
+// Validate returns an error if this port range is invalid
+func (r PortRange) Validate() error {
+	if r.IsZero() {
+		return nil
+	}
+	if r.Min == 0 || r.Min > r.Max {
+		return fmt.Errorf("invalid port range [%d, %d]", r.Min, r.Max)
+	}
+	return nil
 }
 
 // Validate returns an error if this config is invalid
@@ -68,6 +111,17 @@ func (c *Config) Validate() error {
 	case len(c.Genesis) == 0:
 		return errors.New("no genesis given")
 	}
+	if err := c.PortRange.Validate(); err != nil {
+		return err
+	}
+	if c.StopGracePeriod < 0 {
+		return fmt.Errorf("negative stop grace period %s", c.StopGracePeriod)
+	}
+	for _, allocation := range c.CChainAllocations {
+		if err := allocation.Validate(); err != nil {
+			return err
+		}
+	}
 	networkID, err := utils.NetworkIDFromGenesis([]byte(c.Genesis))
 	if err != nil {
 		return fmt.Errorf("couldn't get network ID from genesis: %w", err)
@@ -96,8 +150,8 @@ func (c *Config) Validate() error {
 // The nodes in [genesisVdrs] are validators.
 // The C-Chain and X-Chain balances are given by
 // [cChainBalances] and [xChainBalances].
-// Note that many of the genesis fields (i.e. reward addresses)
-// are randomly generated or hard-coded.
+// Note that many of the genesis fields are hard-coded;
+// use a GenesisBuilder to set them.
 func NewAvalancheGoGenesis(
 	networkID uint32,
 	xChainBalances []AddrAndBalance,
@@ -115,79 +169,37 @@ func NewAvalancheGoGenesis(
 		return nil, errors.New("no genesis balances given")
 	}
 
-	// Address that controls stake doesn't matter -- generate it randomly
-	genesisVdrStakeAddr, _ := formatting.FormatAddress("X", constants.GetHRP(networkID), ids.GenerateTestShortID().Bytes())
-	config := genesis.UnparsedConfig{
+	builder := GenesisBuilder{
 		NetworkID: networkID,
-		Allocations: []genesis.UnparsedAllocation{
-			{
-				ETHAddr:       "0x0000000000000000000000000000000000000000",
-				AVAXAddr:      genesisVdrStakeAddr, // Owner doesn't matter
-				InitialAmount: 0,
-				UnlockSchedule: []genesis.LockedAmount{ // Provides stake to validators
-					{
-						Amount: uint64(len(genesisVdrs)) * validatorStake,
-					},
-				},
-			},
-		},
-		StartTime:                  uint64(time.Now().Unix()),
-		InitialStakedFunds:         []string{genesisVdrStakeAddr},
-		InitialStakeDuration:       31_536_000, // 1 year
-		InitialStakeDurationOffset: 5_400,      // 90 minutes
-		Message:                    "hello world",
+		Message:   "hello world",
 	}
-
 	for _, xChainBal := range xChainBalances {
-		xChainAddr, _ := formatting.FormatAddress("X", constants.GetHRP(networkID), xChainBal.Addr[:])
-		config.Allocations = append(
-			config.Allocations,
-			genesis.UnparsedAllocation{
-				ETHAddr:       "0x0000000000000000000000000000000000000000",
-				AVAXAddr:      xChainAddr,
-				InitialAmount: xChainBal.Balance,
-				UnlockSchedule: []genesis.LockedAmount{
-					{
-						Amount:   validatorStake * uint64(len(genesisVdrs)), // Stake
-						Locktime: uint64(time.Now().Add(7 * 24 * time.Hour).Unix()),
-					},
+		xChainAddr, err := formatting.FormatAddress("X", constants.GetHRP(networkID), xChainBal.Addr[:])
+		if err != nil {
+			return nil, err
+		}
+		builder.Allocations = append(builder.Allocations, GenesisAllocation{
+			Address: xChainAddr,
+			XAmount: xChainBal.Balance,
+			PAmounts: []genesis.LockedAmount{
+				{
+					Amount:   validatorStake * uint64(len(genesisVdrs)), // Stake
+					Locktime: uint64(time.Now().Add(7 * 24 * time.Hour).Unix()),
 				},
 			},
-		)
+		})
 	}
-
-	// Set initial C-Chain balances.
-	cChainAllocs := map[string]interface{}{}
 	for _, cChainBal := range cChainBalances {
-		addrHex := fmt.Sprintf("0x%s", cChainBal.Addr.Hex())
-		balHex := fmt.Sprintf("0x%x", cChainBal.Balance)
-		cChainAllocs[addrHex] = map[string]interface{}{
-			"balance": balHex,
-		}
-	}
-	// avoid modifying original cChainConfig
-	localCChainConfig := map[string]interface{}{}
-	for k, v := range cChainConfig {
-		localCChainConfig[k] = v
+		builder.CChainAllocations = append(builder.CChainAllocations, CChainAllocation{
+			Address: fmt.Sprintf("0x%s", cChainBal.Addr.Hex()),
+			Balance: new(big.Int).SetUint64(cChainBal.Balance),
+		})
 	}
-	localCChainConfig["alloc"] = cChainAllocs
-	cChainConfigBytes, _ := json.Marshal(localCChainConfig)
-	config.CChainGenesis = string(cChainConfigBytes)
-
-	// Set initial validators.
-	// Give staking rewards to random address.
-	rewardAddr, _ := formatting.FormatAddress("X", constants.GetHRP(networkID), ids.GenerateTestShortID().Bytes())
 	for _, genesisVdr := range genesisVdrs {
-		config.InitialStakers = append(
-			config.InitialStakers,
-			genesis.UnparsedStaker{
-				NodeID:        genesisVdr.PrefixedString(constants.NodeIDPrefix),
-				RewardAddress: rewardAddr,
-				DelegationFee: 10_000,
-			},
-		)
+		builder.Validators = append(builder.Validators, GenesisValidator{
+			NodeID:      genesisVdr.PrefixedString(constants.NodeIDPrefix),
+			StakeAmount: validatorStake,
+		})
 	}
-
-	// TODO add validation (from AvalancheGo's function validateConfig?)
-	return json.Marshal(config)
+	return builder.Build()
 }
@@ -0,0 +1,128 @@
+package network
+
+import (
+	"sync"
+	"time"
+)
+
+// EventType identifies the kind of an Event
+type EventType string
+
+const (
+	// A node was added to the network
+	EventNodeAdded EventType = "node-added"
+	// A node was removed from the network
+	EventNodeRemoved EventType = "node-removed"
+	// The process of a node was started (or restarted)
+	EventNodeStarted EventType = "node-started"
+	// The process of a node exited
+	EventNodeExited EventType = "node-exited"
+	// A node reported healthy
+	EventNodeHealthy EventType = "node-healthy"
+	// A node failed to become healthy
+	EventNodeUnhealthy EventType = "node-unhealthy"
+	// A test peer was attached to a node
+	EventPeerAttached EventType = "peer-attached"
+	// A subnet was created for a custom VM
+	EventSubnetCreated EventType = "subnet-created"
+	// A blockchain was created for a custom VM
+	EventBlockchainCreated EventType = "blockchain-created"
+	// All the custom VMs are ready
+	EventCustomVMsReady EventType = "custom-vms-ready"
+	// The network failed to start
+	EventStartFailed EventType = "start-failed"
+	// A node is connected to a peer without going through the P2P proxies
+	EventProxyBypassed EventType = "proxy-bypassed"
+)
+
+// Number of events buffered for each subscriber.
+// Events are dropped for subscribers that fall behind.
+const eventsBufferSize = 1024
+
+// EventTypes holds all the known event types
+var EventTypes = []EventType{
+	EventNodeAdded,
+	EventNodeRemoved,
+	EventNodeStarted,
+	EventNodeExited,
+	EventNodeHealthy,
+	EventNodeUnhealthy,
+	EventPeerAttached,
+	EventSubnetCreated,
+	EventBlockchainCreated,
+	EventCustomVMsReady,
+	EventStartFailed,
+	EventProxyBypassed,
+}
+
+// Event is something that happened in a network
+type Event struct {
+	Type EventType
+	// Time the event happened at
+	Time time.Time
+	// Name of the node the event is about.
+	// Empty for events about the whole network.
+	NodeName string
+	// Details about the event (e.g. the exit code of a node).
+	// May be empty.
+	Message string
+}
+
+// NewEvent returns an event of type [eventType] about
+// node [nodeName], happening now
+func NewEvent(eventType EventType, nodeName string, message string) Event {
+	return Event{
+		Type:     eventType,
+		Time:     time.Now(),
+		NodeName: nodeName,
+		Message:  message,
+	}
+}
+
+// EventBus sends the events published on it to all its subscribers.
+// The zero value is ready to use.
+type EventBus struct {
+	lock        sync.Mutex
+	nextID      uint64
+	subscribers map[uint64]chan Event
+}
+
+// Publish sends [event] to all the subscribers, without blocking.
+// The event is dropped for subscribers whose buffer is full.
+func (b *EventBus) Publish(event Event) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for _, ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel receiving the events published from
+// now on, and a function to unsubscribe, which closes the channel.
+func (b *EventBus) Subscribe() (<-chan Event, func()) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.subscribers == nil {
+		b.subscribers = map[uint64]chan Event{}
+	}
+	id := b.nextID
+	b.nextID++
+	ch := make(chan Event, eventsBufferSize)
+	b.subscribers[id] = ch
+	once := sync.Once{}
+	unsubscribe := func() {
+		once.Do(func() {
+			b.lock.Lock()
+			defer b.lock.Unlock()
+
+			delete(b.subscribers, id)
+			close(ch)
+		})
+	}
+	return ch, unsubscribe
+}
@@ -0,0 +1,39 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventBus(t *testing.T) {
+	assert := assert.New(t)
+	bus := &EventBus{}
+	// no subscribers
+	bus.Publish(NewEvent(EventNodeAdded, "node1", ""))
+
+	ch1, unsubscribe1 := bus.Subscribe()
+	ch2, unsubscribe2 := bus.Subscribe()
+	bus.Publish(NewEvent(EventNodeAdded, "node2", ""))
+	event := <-ch1
+	assert.EqualValues(EventNodeAdded, event.Type)
+	assert.EqualValues("node2", event.NodeName)
+	event = <-ch2
+	assert.EqualValues("node2", event.NodeName)
+
+	unsubscribe1()
+	// unsubscribing twice is fine
+	unsubscribe1()
+	_, ok := <-ch1
+	assert.False(ok)
+	bus.Publish(NewEvent(EventNodeRemoved, "node2", ""))
+	event = <-ch2
+	assert.EqualValues(EventNodeRemoved, event.Type)
+
+	// events are dropped for subscribers that fall behind
+	for i := 0; i < eventsBufferSize+1; i++ {
+		bus.Publish(NewEvent(EventNodeHealthy, "node2", ""))
+	}
+	assert.Len(ch2, eventsBufferSize)
+	unsubscribe2()
+}
@@ -0,0 +1,81 @@
+package network
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// LinkFaults are faults injected in the P2P traffic between nodes.
+// The zero value injects no fault.
+type LinkFaults struct {
+	// Time added to the delivery of the traffic
+	Delay time.Duration `json:"delay"`
+	// Random variation of Delay, up to this much either way.
+	// The traffic is still delivered in order.
+	Jitter time.Duration `json:"jitter"`
+	// Max throughput, in bytes per second, in each direction.
+	// If 0, there is no limit.
+	Bandwidth uint64 `json:"bandwidth"`
+	// Probability, in [0, 1], that a new connection is dropped
+	DropProbability float64 `json:"dropProbability"`
+}
+
+// IsZero returns true if no fault is injected
+func (f LinkFaults) IsZero() bool {
+	return f == LinkFaults{}
+}
+
+// Validate returns an error if these faults are invalid
+func (f LinkFaults) Validate() error {
+	switch {
+	case f.Delay < 0:
+		return fmt.Errorf("negative delay %s", f.Delay)
+	case f.Jitter < 0:
+		return fmt.Errorf("negative jitter %s", f.Jitter)
+	case f.DropProbability < 0 || f.DropProbability > 1:
+		return fmt.Errorf("drop probability %v not in [0, 1]", f.DropProbability)
+	}
+	return nil
+}
+
+// Add returns the faults of traffic that goes through both
+// [f] and [other]: delays add up, the lowest bandwidth applies,
+// and drops happen if either would happen.
+func (f LinkFaults) Add(other LinkFaults) LinkFaults {
+	bandwidth := f.Bandwidth
+	if bandwidth == 0 || (other.Bandwidth != 0 && other.Bandwidth < bandwidth) {
+		bandwidth = other.Bandwidth
+	}
+	return LinkFaults{
+		Delay:           f.Delay + other.Delay,
+		Jitter:          f.Jitter + other.Jitter,
+		Bandwidth:       bandwidth,
+		DropProbability: addProbabilities(f.DropProbability, other.DropProbability),
+	}
+}
+
+// addProbabilities returns the probability that either
+// of two independent events, of probabilities [p1] and [p2], happens
+func addProbabilities(p1 float64, p2 float64) float64 {
+	switch {
+	case p1 == 0:
+		return p2
+	case p2 == 0:
+		return p1
+	}
+	return 1 - (1-p1)*(1-p2)
+}
+
+// SampleDelay returns the delay of a piece of traffic,
+// which is Delay plus or minus up to Jitter, and not negative
+func (f LinkFaults) SampleDelay() time.Duration {
+	delay := f.Delay
+	if f.Jitter > 0 {
+		delay += time.Duration(rand.Int63n(2*int64(f.Jitter)+1)) - f.Jitter
+	}
+	if delay < 0 {
+		return 0
+	}
+	return delay
+}
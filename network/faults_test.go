@@ -0,0 +1,45 @@
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkFaults(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(network.LinkFaults{}.IsZero())
+	assert.NoError(network.LinkFaults{}.Validate())
+	assert.Error(network.LinkFaults{Delay: -time.Second}.Validate())
+	assert.Error(network.LinkFaults{Jitter: -time.Second}.Validate())
+	assert.Error(network.LinkFaults{DropProbability: 1.5}.Validate())
+
+	faults1 := network.LinkFaults{
+		Delay:           100 * time.Millisecond,
+		Jitter:          10 * time.Millisecond,
+		Bandwidth:       1000,
+		DropProbability: 0.5,
+	}
+	faults2 := network.LinkFaults{
+		Delay:           50 * time.Millisecond,
+		Bandwidth:       500,
+		DropProbability: 0.5,
+	}
+	assert.Equal(network.LinkFaults{
+		Delay:           150 * time.Millisecond,
+		Jitter:          10 * time.Millisecond,
+		Bandwidth:       500,
+		DropProbability: 0.75,
+	}, faults1.Add(faults2))
+	assert.Equal(faults1, faults1.Add(network.LinkFaults{}))
+	assert.Equal(faults1, network.LinkFaults{}.Add(faults1))
+
+	for i := 0; i < 100; i++ {
+		delay := faults1.SampleDelay()
+		assert.GreaterOrEqual(delay, 90*time.Millisecond)
+		assert.LessOrEqual(delay, 110*time.Millisecond)
+	}
+}
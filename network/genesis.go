@@ -0,0 +1,274 @@
+package network
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+// Defaults of the genesis built by a GenesisBuilder
+const (
+	DefaultGenesisStakeAmount    = units.MegaAvax
+	DefaultGenesisStakeDuration  = 365 * 24 * 60 * 60 // 1 year, in seconds
+	DefaultGenesisDelegationFee  = 10_000             // 1%
+	DefaultGenesisCChainID       = 43115
+	DefaultGenesisCChainGasLimit = 100_000_000
+)
+
+const zeroETHAddr = "0x0000000000000000000000000000000000000000"
+
+var (
+	errNoGenesisValidators = errors.New("no genesis validators given")
+	errUnequalStakes       = errors.New("genesis validators must stake the same amount, as the initial staked funds are split equally between them")
+	errUnevenDurations     = errors.New("genesis validator stake durations must be evenly spaced, as they end at a fixed interval from each other")
+)
+
+// GenesisValidator is a validator of the primary network from the genesis
+type GenesisValidator struct {
+	// ID of the node, e.g. NodeID-7Xhw2mDxuDS44j42TCB6U5579esbSt3Lg
+	NodeID string `json:"nodeID"`
+	// Amount staked, in nAVAX. Defaults to DefaultGenesisStakeAmount.
+	StakeAmount uint64 `json:"stakeAmount"`
+	// Time the node validates for, from the start time, in seconds.
+	// Defaults to DefaultGenesisStakeDuration.
+	StakeDuration uint64 `json:"stakeDuration"`
+	// Fee charged to delegators, in units of 0.0001%.
+	// Defaults to DefaultGenesisDelegationFee.
+	DelegationFee uint32 `json:"delegationFee"`
+	// Address the staking rewards are sent to, e.g. X-custom1...
+	// Defaults to the first address of the initial staked funds.
+	RewardAddress string `json:"rewardAddress"`
+}
+
+// GenesisAllocation gives AVAX to an address from the genesis
+type GenesisAllocation struct {
+	// AVAX address, on any chain, e.g. X-custom18jma8ppw3nhx5r4ap8clazz0dps7rv5u9xde7p
+	Address string `json:"address"`
+	// ETH address the allocation is claimed with. Defaults to the zero address.
+	ETHAddress string `json:"ethAddress"`
+	// Unlocked amount on the X-Chain, in nAVAX
+	XAmount uint64 `json:"xAmount"`
+	// Amounts on the P-Chain, in nAVAX, each locked until its locktime,
+	// which is a Unix time in seconds, or unlocked if it's 0
+	PAmounts []genesis.LockedAmount `json:"pAmounts"`
+}
+
+// GenesisBuilder builds the genesis of a network, in the format of the
+// avalanchego genesis file. Fields left empty get defaults.
+type GenesisBuilder struct {
+	// Must not be the ID of mainnet, testnet or the local network
+	NetworkID uint32 `json:"networkID"`
+	// Unix time of the genesis, in seconds, which can't be in the future.
+	// Defaults to the time Build is called.
+	StartTime uint64 `json:"startTime"`
+	Message   string `json:"message"`
+	// Must not be empty
+	Validators []GenesisValidator `json:"validators"`
+	// Allocations on the X-Chain and the P-Chain
+	Allocations []GenesisAllocation `json:"allocations"`
+	// Addresses of allocations whose P-Chain amounts are staked by the
+	// validators until the end of their validation, split equally between them.
+	// If empty, the stake of the validators is allocated to the address of
+	// the zero ID, which nobody can spend from.
+	InitialStakedFunds []string `json:"initialStakedFunds"`
+	// Defaults to DefaultGenesisCChainID
+	CChainID uint64 `json:"cChainID"`
+	// Defaults to DefaultGenesisCChainGasLimit
+	CChainGasLimit uint64 `json:"cChainGasLimit"`
+	// Accounts of the C-Chain
+	CChainAllocations []CChainAllocation `json:"cChainAllocations"`
+}
+
+// Build returns the genesis, in the format of the avalanchego genesis file,
+// or an error if avalanchego would reject it
+func (b *GenesisBuilder) Build() ([]byte, error) {
+	if len(b.Validators) == 0 {
+		return nil, errNoGenesisValidators
+	}
+	hrp := constants.GetHRP(b.NetworkID)
+	startTime := b.StartTime
+	if startTime == 0 {
+		startTime = uint64(time.Now().Unix())
+	}
+	config := genesis.UnparsedConfig{
+		NetworkID: b.NetworkID,
+		StartTime: startTime,
+		Message:   b.Message,
+	}
+
+	for _, allocation := range b.Allocations {
+		ethAddr := allocation.ETHAddress
+		if ethAddr == "" {
+			ethAddr = zeroETHAddr
+		}
+		config.Allocations = append(config.Allocations, genesis.UnparsedAllocation{
+			ETHAddr:        ethAddr,
+			AVAXAddr:       allocation.Address,
+			InitialAmount:  allocation.XAmount,
+			UnlockSchedule: allocation.PAmounts,
+		})
+	}
+
+	// The stake of each validator is an equal share of the initial staked funds
+	stakeAmount := b.Validators[0].StakeAmount
+	if stakeAmount == 0 {
+		stakeAmount = DefaultGenesisStakeAmount
+	}
+	for _, validator := range b.Validators[1:] {
+		amount := validator.StakeAmount
+		if amount == 0 {
+			amount = DefaultGenesisStakeAmount
+		}
+		if amount != stakeAmount {
+			return nil, errUnequalStakes
+		}
+	}
+	if len(b.InitialStakedFunds) == 0 {
+		stakeAddr, err := formatting.FormatAddress("X", hrp, ids.ShortEmpty.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		config.Allocations = append(config.Allocations, genesis.UnparsedAllocation{
+			ETHAddr:  zeroETHAddr,
+			AVAXAddr: stakeAddr,
+			UnlockSchedule: []genesis.LockedAmount{
+				{Amount: stakeAmount * uint64(len(b.Validators))},
+			},
+		})
+		config.InitialStakedFunds = []string{stakeAddr}
+	} else {
+		config.InitialStakedFunds = b.InitialStakedFunds
+		stakedAmount, err := b.getStakedAmount()
+		if err != nil {
+			return nil, err
+		}
+		if stakedAmount != stakeAmount*uint64(len(b.Validators)) {
+			return nil, fmt.Errorf(
+				"initial staked funds of %d nAVAX aren't the stake of %d validators of %d nAVAX",
+				stakedAmount,
+				len(b.Validators),
+				stakeAmount,
+			)
+		}
+	}
+
+	// Validators are listed by decreasing stake duration,
+	// as each one validates for the offset less than the previous one
+	validators := make([]GenesisValidator, len(b.Validators))
+	copy(validators, b.Validators)
+	for i := range validators {
+		if validators[i].StakeDuration == 0 {
+			validators[i].StakeDuration = DefaultGenesisStakeDuration
+		}
+	}
+	sort.SliceStable(validators, func(i, j int) bool {
+		return validators[i].StakeDuration > validators[j].StakeDuration
+	})
+	config.InitialStakeDuration = validators[0].StakeDuration
+	if len(validators) > 1 {
+		config.InitialStakeDurationOffset = validators[0].StakeDuration - validators[1].StakeDuration
+	}
+	for i, validator := range validators {
+		if validator.StakeDuration != config.InitialStakeDuration-uint64(i)*config.InitialStakeDurationOffset {
+			return nil, errUnevenDurations
+		}
+		delegationFee := validator.DelegationFee
+		if delegationFee == 0 {
+			delegationFee = DefaultGenesisDelegationFee
+		}
+		rewardAddr := validator.RewardAddress
+		if rewardAddr == "" {
+			rewardAddr = config.InitialStakedFunds[0]
+		}
+		config.InitialStakers = append(config.InitialStakers, genesis.UnparsedStaker{
+			NodeID:        validator.NodeID,
+			RewardAddress: rewardAddr,
+			DelegationFee: delegationFee,
+		})
+	}
+
+	cChainGenesis, err := b.buildCChainGenesis()
+	if err != nil {
+		return nil, err
+	}
+	config.CChainGenesis = cChainGenesis
+
+	genesisBytes, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	// The genesis flag of avalanchego is base64 encoded
+	if _, _, err := genesis.FromFlag(b.NetworkID, base64.StdEncoding.EncodeToString(genesisBytes)); err != nil {
+		return nil, err
+	}
+	return genesisBytes, nil
+}
+
+// getStakedAmount returns the sum of the P-Chain amounts
+// of the allocations of the initial staked funds
+func (b *GenesisBuilder) getStakedAmount() (uint64, error) {
+	stakedAddrs := make(map[string]struct{}, len(b.InitialStakedFunds))
+	for _, addr := range b.InitialStakedFunds {
+		stakedAddrs[addr] = struct{}{}
+	}
+	amount := uint64(0)
+	for _, allocation := range b.Allocations {
+		if _, ok := stakedAddrs[allocation.Address]; !ok {
+			continue
+		}
+		for _, locked := range allocation.PAmounts {
+			if amount+locked.Amount < amount {
+				return 0, errors.New("initial staked funds overflow")
+			}
+			amount += locked.Amount
+		}
+	}
+	return amount, nil
+}
+
+// buildCChainGenesis returns the genesis of the C-Chain
+func (b *GenesisBuilder) buildCChainGenesis() (string, error) {
+	chainID := b.CChainID
+	if chainID == 0 {
+		chainID = DefaultGenesisCChainID
+	}
+	gasLimit := b.CChainGasLimit
+	if gasLimit == 0 {
+		gasLimit = DefaultGenesisCChainGasLimit
+	}
+
+	// avoid modifying original cChainConfig
+	localCChainConfig := map[string]interface{}{}
+	for k, v := range cChainConfig {
+		localCChainConfig[k] = v
+	}
+	chainConfig := map[string]interface{}{}
+	for k, v := range cChainConfig["config"].(map[string]interface{}) {
+		chainConfig[k] = v
+	}
+	chainConfig["chainId"] = chainID
+	localCChainConfig["config"] = chainConfig
+	localCChainConfig["gasLimit"] = fmt.Sprintf("0x%x", gasLimit)
+
+	for _, allocation := range b.CChainAllocations {
+		if err := allocation.Validate(); err != nil {
+			return "", err
+		}
+	}
+	localCChainConfig["alloc"] = addToAlloc(nil, b.CChainAllocations)
+
+	cChainConfigBytes, err := json.Marshal(localCChainConfig)
+	if err != nil {
+		return "", err
+	}
+	return string(cChainConfigBytes), nil
+}
@@ -0,0 +1,120 @@
+package network_test
+
+import (
+	"encoding/base64"
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenesisBuilder(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	const (
+		nodeID1   = "NodeID-7Xhw2mDxuDS44j42TCB6U5579esbSt3Lg"
+		nodeID2   = "NodeID-MFrZFVCXPv5iCn6M9K6XduxGTYp891xXZ"
+		nodeID3   = "NodeID-NFBbbJ4qCmNaCzeW7sxErhvWqvEQMnYcN"
+		addr      = "X-custom18jma8ppw3nhx5r4ap8clazz0dps7rv5u9xde7p"
+		stakeAddr = "X-custom16045mxr3s2cjycqe2xfluk304xv3ezhkhsvkpr"
+	)
+	newBuilder := func() network.GenesisBuilder {
+		return network.GenesisBuilder{
+			NetworkID: 1337,
+			StartTime: 1630987200,
+			Message:   "genesis",
+			Validators: []network.GenesisValidator{
+				{NodeID: nodeID1, StakeDuration: 1000, DelegationFee: 20_000},
+				{NodeID: nodeID2, StakeDuration: 3000, RewardAddress: addr},
+				{NodeID: nodeID3, StakeDuration: 2000},
+			},
+			Allocations: []network.GenesisAllocation{
+				{
+					Address:  addr,
+					XAmount:  units.KiloAvax,
+					PAmounts: []genesis.LockedAmount{{Amount: units.Avax, Locktime: 1633824000}},
+				},
+			},
+			CChainID:       1234,
+			CChainGasLimit: 8_000_000,
+			CChainAllocations: []network.CChainAllocation{
+				{Address: "0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC", Balance: big.NewInt(1000)},
+			},
+		}
+	}
+
+	// Validators are ordered by stake duration, with evenly spaced end times
+	builder := newBuilder()
+	genesisBytes, err := builder.Build()
+	assert.NoError(err)
+	config, err := genesis.GetConfigContent(base64.StdEncoding.EncodeToString(genesisBytes))
+	assert.NoError(err)
+	assert.EqualValues(1337, config.NetworkID)
+	assert.EqualValues(1630987200, config.StartTime)
+	assert.Equal("genesis", config.Message)
+	assert.EqualValues(3000, config.InitialStakeDuration)
+	assert.EqualValues(1000, config.InitialStakeDurationOffset)
+	assert.Len(config.InitialStakers, 3)
+	assert.Equal(nodeID2, config.InitialStakers[0].NodeID.PrefixedString("NodeID-"))
+	assert.Equal(nodeID3, config.InitialStakers[1].NodeID.PrefixedString("NodeID-"))
+	assert.Equal(nodeID1, config.InitialStakers[2].NodeID.PrefixedString("NodeID-"))
+	assert.EqualValues(network.DefaultGenesisDelegationFee, config.InitialStakers[1].DelegationFee)
+	assert.EqualValues(20_000, config.InitialStakers[2].DelegationFee)
+	supply, err := config.InitialSupply()
+	assert.NoError(err)
+	assert.EqualValues(units.KiloAvax+units.Avax+3*network.DefaultGenesisStakeAmount, supply)
+	assert.Contains(config.CChainGenesis, `"chainId":1234`)
+	assert.Contains(config.CChainGenesis, `"gasLimit":"0x7a1200"`)
+	assert.Contains(config.CChainGenesis, `"balance":"0x3e8"`)
+
+	// The stake can be allocated to given addresses
+	builder = newBuilder()
+	builder.Allocations = append(builder.Allocations, network.GenesisAllocation{
+		Address:  stakeAddr,
+		PAmounts: []genesis.LockedAmount{{Amount: 3 * units.KiloAvax}},
+	})
+	builder.InitialStakedFunds = []string{stakeAddr}
+	for i := range builder.Validators {
+		builder.Validators[i].StakeAmount = units.KiloAvax
+	}
+	_, err = builder.Build()
+	assert.NoError(err)
+	builder.Validators[0].StakeAmount = 2 * units.KiloAvax
+	_, err = builder.Build()
+	assert.Error(err)
+	builder.Validators[0].StakeAmount = 0
+	_, err = builder.Build()
+	assert.Error(err)
+
+	// Invalid genesis
+	for name, modify := range map[string]func(*network.GenesisBuilder){
+		"no validators":      func(b *network.GenesisBuilder) { b.Validators = nil },
+		"uneven durations":   func(b *network.GenesisBuilder) { b.Validators[0].StakeDuration = 1500 },
+		"local network ID":   func(b *network.GenesisBuilder) { b.NetworkID = 12345 },
+		"future start time":  func(b *network.GenesisBuilder) { b.StartTime = 1 << 40 },
+		"invalid node ID":    func(b *network.GenesisBuilder) { b.Validators[0].NodeID = "node" },
+		"invalid address":    func(b *network.GenesisBuilder) { b.Allocations[0].Address = "X-local1abc" },
+		"invalid C address":  func(b *network.GenesisBuilder) { b.CChainAllocations[0].Address = "0x1234" },
+		"negative C balance": func(b *network.GenesisBuilder) { b.CChainAllocations[0].Balance = big.NewInt(-1) },
+		"unallocated staker": func(b *network.GenesisBuilder) { b.InitialStakedFunds = []string{stakeAddr} },
+	} {
+		builder := newBuilder()
+		modify(&builder)
+		_, err := builder.Build()
+		assert.Error(err, name)
+	}
+
+	// NewAvalancheGoGenesis builds valid genesis
+	_, err = network.NewAvalancheGoGenesis(
+		1337,
+		[]network.AddrAndBalance{{Addr: ids.GenerateTestShortID(), Balance: units.Avax}},
+		[]network.AddrAndBalance{{Addr: ids.GenerateTestShortID(), Balance: units.Avax}},
+		[]ids.ShortID{ids.GenerateTestShortID(), ids.GenerateTestShortID()},
+	)
+	assert.NoError(err)
+}
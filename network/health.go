@@ -0,0 +1,73 @@
+package network
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/ava-labs/avalanchego/api/health"
+)
+
+// HealthReport is the health of a node, as reported by its health API
+type HealthReport struct {
+	NodeName string
+	// Time the node was checked at.
+	// Zero if the node wasn't checked yet.
+	Time    time.Time
+	Healthy bool
+	// Result of each health check of the node, by check name
+	Checks map[string]HealthCheck
+	// Set if the node couldn't report its health
+	// (e.g. its API isn't reachable, or it's paused).
+	Error string
+}
+
+// HealthCheck is the result of one of the health checks of a node
+type HealthCheck struct {
+	Healthy bool
+	// Details given by the check, as JSON. May be empty.
+	Message string
+	// Error of the check if it's failing
+	Error string
+	// Number of times in a row the check failed
+	ContiguousFailures int64
+}
+
+// NewHealthReport returns the health report of node [nodeName]
+// from the reply [reply] of its health API
+func NewHealthReport(nodeName string, reply *health.APIHealthReply) HealthReport {
+	report := HealthReport{
+		NodeName: nodeName,
+		Time:     time.Now(),
+		Healthy:  reply.Healthy,
+		Checks:   make(map[string]HealthCheck, len(reply.Checks)),
+	}
+	for name, result := range reply.Checks {
+		check := HealthCheck{
+			Healthy:            result.Error == nil,
+			ContiguousFailures: result.ContiguousFailures,
+		}
+		if result.Error != nil {
+			check.Error = *result.Error
+		}
+		if result.Details != nil {
+			if message, err := json.Marshal(result.Details); err == nil {
+				check.Message = string(message)
+			}
+		}
+		report.Checks[name] = check
+	}
+	return report
+}
+
+// FailingChecks returns the sorted names of the failing checks of the node
+func (r HealthReport) FailingChecks() []string {
+	failingChecks := []string{}
+	for name, check := range r.Checks {
+		if !check.Healthy {
+			failingChecks = append(failingChecks, name)
+		}
+	}
+	sort.Strings(failingChecks)
+	return failingChecks
+}
@@ -7,7 +7,12 @@ import (
 	"github.com/ava-labs/avalanche-network-runner/network/node"
 )
 
-var ErrStopped = errors.New("network stopped")
+var (
+	ErrStopped    = errors.New("network stopped")
+	ErrNodePaused = errors.New("node paused")
+	ErrNodeExited = errors.New("node process exited")
+	ErrNoP2PProxy = errors.New("network doesn't proxy P2P traffic")
+)
 
 // Network is an abstraction of an Avalanche network
 type Network interface {
@@ -20,6 +25,19 @@ type Network interface {
 	// A stopped network is considered unhealthy.
 	// Timeout is given by the context parameter.
 	Healthy(context.Context) chan error
+	// Like Healthy, but only waits for the nodes with these names.
+	// If a node isn't found, an error is sent on the channel.
+	WaitForNodes(ctx context.Context, names ...string) chan error
+	// Query the health API of the node with this name, and return its report.
+	// A paused node, or one whose process exited, is reported unhealthy
+	// without being queried.
+	// Returns ErrStopped if Stop() was previously called.
+	NodeHealth(ctx context.Context, name string) (HealthReport, error)
+	// Return the last health report of each node, as got by
+	// Healthy, WaitForNodes or NodeHealth.
+	// Node name --> Report.
+	// Returns ErrStopped if Stop() was previously called.
+	GetHealthReports() (map[string]HealthReport, error)
 	// Stop all the nodes.
 	// Returns ErrStopped if Stop() was previously called.
 	Stop(context.Context) error
@@ -29,6 +47,21 @@ type Network interface {
 	// Stop the node with this name.
 	// Returns ErrStopped if Stop() was previously called.
 	RemoveNode(name string) error
+	// Freeze the node with this name (SIGSTOP) without
+	// terminating its process or closing its connections.
+	// A paused node is unhealthy: Healthy() fails with
+	// an error wrapping ErrNodePaused while it is paused.
+	// Returns ErrStopped if Stop() was previously called.
+	PauseNode(name string) error
+	// Unfreeze the paused node with this name (SIGCONT).
+	// Returns ErrStopped if Stop() was previously called.
+	ResumeNode(name string) error
+	// Makes the node with this name a beacon if [isBeacon], or else
+	// not a beacon. The nodes added later bootstrap from the new beacons,
+	// while the running nodes keep the beacons they were started with,
+	// even when their restart policy restarts their process.
+	// Returns ErrStopped if Stop() was previously called.
+	SetBeacon(name string, isBeacon bool) error
 	// Return the node with this name.
 	// Returns ErrStopped if Stop() was previously called.
 	GetNode(name string) (node.Node, error)
@@ -39,4 +72,50 @@ type Network interface {
 	// Returns the names of all nodes in this network.
 	// Returns ErrStopped if Stop() was previously called.
 	GetNodeNames() ([]string, error)
+	// Stop all the nodes and save the network state (databases,
+	// node configs, staking keys and certs, genesis and chain configs)
+	// as a snapshot with the given name.
+	// The network remains stopped after the snapshot is taken.
+	// Returns the path to the snapshot.
+	// Returns ErrStopped if Stop() was previously called.
+	SaveSnapshot(ctx context.Context, name string) (string, error)
+	// Stop all the nodes, if any, and restart the network
+	// from the snapshot with the given name.
+	LoadSnapshot(ctx context.Context, name string) error
+	// Remove the snapshot with the given name.
+	RemoveSnapshot(name string) error
+	// Returns the names of all available snapshots.
+	GetSnapshotNames() ([]string, error)
+	// Returns a channel receiving the events of this network
+	// (nodes added, removed, started, exited, healthy or unhealthy),
+	// and a function to unsubscribe, which closes the channel.
+	// Events are dropped if the channel isn't drained fast enough.
+	SubscribeEvents() (<-chan Event, func())
+	// Splits the network in [groups] of node names. The P2P traffic
+	// between nodes of different groups is blocked, until Heal is called.
+	// Nodes not in any group, including nodes added later, are put
+	// together in a group of their own.
+	// Replaces the current partition, if any.
+	// Returns ErrNoP2PProxy if the network doesn't proxy P2P traffic.
+	Partition(groups [][]string) error
+	// Unblocks the P2P traffic blocked by Partition.
+	// Returns ErrNoP2PProxy if the network doesn't proxy P2P traffic.
+	Heal() error
+	// Injects [faults] in the P2P traffic between the node with this name
+	// and each other node, including nodes added later. The faults of both
+	// nodes of a link add up. Zero faults remove those of the node.
+	// Returns ErrNoP2PProxy if the network doesn't proxy P2P traffic.
+	SetNodeFaults(name string, faults LinkFaults) error
+	// Injects [faults] in the P2P traffic between the nodes with these
+	// names, instead of the faults of the nodes. Zero faults remove those
+	// of the link, so the faults of the nodes apply again.
+	// Returns ErrNoP2PProxy if the network doesn't proxy P2P traffic.
+	SetLinkFaults(name1 string, name2 string, faults LinkFaults) error
+	// Restarts nodes onto the avalanchego binary at [binaryPath], a batch at
+	// a time, keeping their ports, databases, staking keys and configs, and
+	// waits for each batch to become healthy before restarting the next one.
+	// If a batch doesn't, returns an *UpgradeError, after restarting the
+	// nodes upgraded so far onto their previous binary if [opts.Rollback].
+	// Returns ErrStopped if Stop() was previously called.
+	RollingUpgrade(ctx context.Context, binaryPath string, opts UpgradeOptions) error
 }
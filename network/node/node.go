@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ava-labs/avalanche-network-runner/api"
 	"github.com/ava-labs/avalanchego/config"
@@ -28,6 +29,19 @@ type Node interface {
 	GetP2PPort() uint16
 	// Return this node's HTP API port.
 	GetAPIPort() uint16
+	// Return the directory this node stores its database in.
+	GetDbDir() string
+	// Return the directory this node writes its logs to.
+	GetLogsDir() string
+	// Return the config this node was started with.
+	GetConfig() Config
+	// Return true if this node is paused.
+	GetPaused() bool
+	// Return the state of the process running this node.
+	GetProcessStatus() ProcessStatus
+	// Return the recent samples of the resources used by this node,
+	// oldest first. Empty if its process can't be sampled.
+	GetResourceUsage() []ResourceUsage
 	// Starts a new test peer, connects it to the given node, and returns the peer.
 	// [handler] defines how the test peer handles messages it receives.
 	// The test peer can be used to send messages to the node it's attached to.
@@ -45,6 +59,11 @@ type Config struct {
 	// True if other nodes should use this node
 	// as a bootstrap beacon.
 	IsBeacon bool `json:"isBeacon"`
+	// Names of the nodes this node bootstraps from, instead of the
+	// beacons of the network. The nodes not in the network when
+	// this node is added are skipped.
+	// May be nil.
+	BootstrapNodes []string `json:"bootstrapNodes"`
 	// Must not be nil.
 	StakingKey string `json:"stakingKey"`
 	// Must not be nil.
@@ -53,6 +72,10 @@ type Config struct {
 	ConfigFile string `json:"configFile"`
 	// May be nil.
 	CChainConfigFile string `json:"cChainConfigFile"`
+	// Config files of other chains, by chain alias or ID.
+	// The config of the C-Chain is CChainConfigFile.
+	// May be nil.
+	ChainConfigFiles map[string]string `json:"chainConfigFiles"`
 	// Flags can hold additional flags for the node.
 	// It can be empty.
 	// The precedence of flags handling is:
@@ -66,6 +89,134 @@ type Config struct {
 	RedirectStdout bool `json:"redirectStdout"`
 	// If non-nil, direct this node's Stderr to os.Stderr
 	RedirectStderr bool `json:"redirectStderr"`
+	// What to do when this node's process exits
+	// without being stopped by the network.
+	RestartPolicy RestartPolicy `json:"restartPolicy"`
+	// Files this node's stdout and stderr are written to.
+	OutputLogs OutputLogs `json:"outputLogs"`
+}
+
+const (
+	// Name of the file the stdout of a node is written to
+	StdoutLogFileName = "stdout.log"
+	// Name of the file the stderr of a node is written to
+	StderrLogFileName = "stderr.log"
+	// Size a file of node output is rotated at by default
+	DefaultOutputLogMaxSize = 10 * 1024 * 1024
+	// Number of rotated files of node output kept by default
+	DefaultOutputLogMaxFiles = 5
+)
+
+// OutputLogs defines the files the stdout and stderr of a node's process
+// are written to: StdoutLogFileName and StderrLogFileName under Dir.
+// When a file reaches MaxSize, it's copied to one with suffix ".1" and
+// truncated, the previously rotated files are shifted (".1" to ".2",
+// and so on), and the ones past MaxFiles are removed.
+type OutputLogs struct {
+	// If empty, the node's dir is used.
+	Dir string `json:"dir"`
+	// Max size of a file, in bytes.
+	// If 0, DefaultOutputLogMaxSize is used.
+	MaxSize int64 `json:"maxSize"`
+	// Max number of rotated files kept of each output.
+	// If 0, DefaultOutputLogMaxFiles is used.
+	MaxFiles int `json:"maxFiles"`
+}
+
+// Validate returns an error if these output logs are invalid
+func (o *OutputLogs) Validate() error {
+	if o.MaxSize < 0 {
+		return fmt.Errorf("negative output log max size %d", o.MaxSize)
+	}
+	if o.MaxFiles < 0 {
+		return fmt.Errorf("negative output log max files %d", o.MaxFiles)
+	}
+	return nil
+}
+
+// RestartMode tells when the process of a node is restarted
+type RestartMode string
+
+const (
+	// Never restart the process. This is the default.
+	RestartNever RestartMode = "never"
+	// Restart the process if it exits with an error,
+	// up to RestartPolicy.MaxRetries times.
+	RestartOnFailure RestartMode = "on-failure"
+	// Restart the process whenever it exits.
+	RestartAlways RestartMode = "always"
+)
+
+// RestartPolicy defines how the process of a node is restarted
+// when it exits without being stopped by the network
+type RestartPolicy struct {
+	// If empty, RestartNever is used.
+	Mode RestartMode `json:"mode"`
+	// Max number of restarts when Mode is RestartOnFailure.
+	// If 0, there is no limit.
+	MaxRetries uint32 `json:"maxRetries"`
+	// Time to wait before the first restart. It's doubled
+	// on each following restart, up to a limit.
+	// If 0, a default is used.
+	Backoff time.Duration `json:"backoff"`
+}
+
+// Validate returns an error if this restart policy is invalid
+func (p *RestartPolicy) Validate() error {
+	switch p.Mode {
+	case "", RestartNever, RestartOnFailure, RestartAlways:
+	default:
+		return fmt.Errorf("unknown restart mode %q", p.Mode)
+	}
+	if p.Backoff < 0 {
+		return fmt.Errorf("negative restart backoff %s", p.Backoff)
+	}
+	return nil
+}
+
+// ProcessStatus describes the process running a node
+type ProcessStatus struct {
+	// True while the process is running (or paused).
+	Running bool
+	// True while the process is waiting to be restarted.
+	Restarting bool
+	// Number of times the process exited without
+	// being stopped by the network.
+	Crashes uint32
+	// Number of times the process was restarted.
+	Restarts uint32
+	// Exit code of the last exit. -1 if the process was
+	// killed by a signal or the exit code is unknown.
+	ExitCode int
+	// Name of the signal that killed the process on the
+	// last exit, if any.
+	ExitSignal string
+	// Time of the last exit. Zero if the process never exited.
+	ExitTime time.Time
+}
+
+// ResourceUsage is a sample of the resources used by a node
+type ResourceUsage struct {
+	// Time of the sample.
+	Time time.Time
+	// CPU time (user and system) used by the process, in seconds.
+	CPUSeconds float64
+	// Percentage of a CPU used by the process since the
+	// previous sample. 0 for the first sample.
+	CPUPercent float64
+	// Resident set size of the process.
+	RSSBytes uint64
+	// Number of threads of the process.
+	Threads int
+	// Number of file descriptors open by the process.
+	OpenFDs int
+	// Bytes read and written by the process from and to storage.
+	ReadBytes  uint64
+	WriteBytes uint64
+	// Size of the files in the db dir of the node.
+	DBDirBytes uint64
+	// Size of the files in the logs dir of the node.
+	LogsDirBytes uint64
 }
 
 // Validate returns an error if this config is invalid
@@ -75,9 +226,22 @@ func (c *Config) Validate(expectedNetworkID uint32) error {
 		return errors.New("staking key not given")
 	case c.StakingCert == "":
 		return errors.New("staking cert not given")
-	default:
-		return validateConfigFile([]byte(c.ConfigFile), expectedNetworkID)
 	}
+	if err := c.RestartPolicy.Validate(); err != nil {
+		return err
+	}
+	if err := c.OutputLogs.Validate(); err != nil {
+		return err
+	}
+	for _, bootstrapNode := range c.BootstrapNodes {
+		if c.Name != "" && bootstrapNode == c.Name {
+			return fmt.Errorf("node %q can't bootstrap from itself", c.Name)
+		}
+	}
+	if _, ok := c.ChainConfigFiles["C"]; ok {
+		return errors.New("C-Chain config given in chain config files instead of C-Chain config file")
+	}
+	return validateConfigFile([]byte(c.ConfigFile), expectedNetworkID)
 }
 
 // Returns an error if config file [configFile] is invalid.
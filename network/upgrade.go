@@ -0,0 +1,72 @@
+package network
+
+import (
+	"fmt"
+	"time"
+)
+
+// Default time to wait for a batch of upgraded nodes to become healthy
+const DefaultUpgradeHealthTimeout = 5 * time.Minute
+
+// UpgradeOptions tell how a rolling upgrade restarts the nodes
+type UpgradeOptions struct {
+	// Names of the nodes to upgrade, in the order they're upgraded.
+	// If empty, all the nodes are upgraded, sorted by name.
+	NodeNames []string
+	// Number of nodes restarted at once. Defaults to 1.
+	BatchSize int
+	// Time to wait for each batch to become healthy.
+	// Defaults to DefaultUpgradeHealthTimeout.
+	HealthTimeout time.Duration
+	// If true, when a batch doesn't become healthy, the nodes
+	// upgraded so far are restarted onto their previous binary.
+	// Otherwise they're left as they are.
+	Rollback bool
+}
+
+// Validate returns an error if these options are invalid
+func (o UpgradeOptions) Validate() error {
+	switch {
+	case o.BatchSize < 0:
+		return fmt.Errorf("negative batch size %d", o.BatchSize)
+	case o.HealthTimeout < 0:
+		return fmt.Errorf("negative health timeout %s", o.HealthTimeout)
+	}
+	seen := make(map[string]struct{}, len(o.NodeNames))
+	for _, nodeName := range o.NodeNames {
+		if _, ok := seen[nodeName]; ok {
+			return fmt.Errorf("node %q given more than once", nodeName)
+		}
+		seen[nodeName] = struct{}{}
+	}
+	return nil
+}
+
+// UpgradeError is returned by a rolling upgrade that stopped
+// because a batch of nodes didn't become healthy
+type UpgradeError struct {
+	// Nodes restarted onto the new binary, including the failed batch
+	Upgraded []string
+	// Nodes of the batch that didn't become healthy
+	FailedBatch []string
+	// True if the upgraded nodes were restarted onto their previous binary
+	RolledBack bool
+	// Why the batch failed
+	Err error
+	// Set if the upgraded nodes couldn't be rolled back
+	RollbackErr error
+}
+
+func (e *UpgradeError) Error() string {
+	switch {
+	case e.RollbackErr != nil:
+		return fmt.Sprintf("upgrade of nodes %v failed: %s; couldn't roll back %v: %s", e.FailedBatch, e.Err, e.Upgraded, e.RollbackErr)
+	case e.RolledBack:
+		return fmt.Sprintf("upgrade of nodes %v failed, rolled back %v: %s", e.FailedBatch, e.Upgraded, e.Err)
+	}
+	return fmt.Sprintf("upgrade of nodes %v failed, upgraded %v: %s", e.FailedBatch, e.Upgraded, e.Err)
+}
+
+func (e *UpgradeError) Unwrap() error {
+	return e.Err
+}
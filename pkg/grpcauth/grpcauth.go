@@ -0,0 +1,259 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package grpcauth implements the TLS and bearer token authentication
+// shared by the gRPC servers of the network runner and their clients.
+package grpcauth
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata key, and HTTP header, of the bearer token
+const AuthorizationKey = "authorization"
+
+const bearerPrefix = "Bearer "
+
+var (
+	ErrEmptyToken       = errors.New("empty auth token")
+	ErrInvalidTLSConfig = errors.New("TLS cert and key must be both given, and given if the client CA is")
+)
+
+// Authenticator checks the bearer tokens of the requests
+type Authenticator struct {
+	tokens         [][]byte
+	readOnlyTokens [][]byte
+	// Full names of the RPCs that the read-only tokens can call
+	readOnlyMethods map[string]struct{}
+}
+
+// NewAuthenticator returns an authenticator accepting [tokens] for all
+// the RPCs, and [readOnlyTokens] for the ones of [readOnlyMethods].
+// Returns nil if no tokens are given, so that requests aren't checked.
+func NewAuthenticator(tokens []string, readOnlyTokens []string, readOnlyMethods map[string]struct{}) (*Authenticator, error) {
+	if len(tokens) == 0 && len(readOnlyTokens) == 0 {
+		return nil, nil
+	}
+	a := &Authenticator{readOnlyMethods: readOnlyMethods}
+	for _, token := range tokens {
+		if token == "" {
+			return nil, ErrEmptyToken
+		}
+		a.tokens = append(a.tokens, []byte(token))
+	}
+	for _, token := range readOnlyTokens {
+		if token == "" {
+			return nil, ErrEmptyToken
+		}
+		a.readOnlyTokens = append(a.readOnlyTokens, []byte(token))
+	}
+	return a, nil
+}
+
+// CheckToken returns an error unless [authorization], the value of an
+// authorization header, carries one of the tokens, and true if it's a
+// read-only one. The tokens are compared in constant time.
+func (a *Authenticator) CheckToken(authorization string) (bool, error) {
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return false, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	token := []byte(strings.TrimPrefix(authorization, bearerPrefix))
+	if containsToken(a.tokens, token) {
+		return false, nil
+	}
+	if containsToken(a.readOnlyTokens, token) {
+		return true, nil
+	}
+	return false, status.Error(codes.Unauthenticated, "invalid bearer token")
+}
+
+func containsToken(tokens [][]byte, token []byte) bool {
+	found := false
+	for _, t := range tokens {
+		if subtle.ConstantTimeCompare(t, token) == 1 {
+			found = true
+		}
+	}
+	return found
+}
+
+// authorize returns an error unless the metadata of [ctx]
+// carries a token allowed to call [fullMethod]
+func (a *Authenticator) authorize(ctx context.Context, fullMethod string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(AuthorizationKey)
+	if len(values) == 0 {
+		return status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	readOnly, err := a.CheckToken(values[0])
+	if err != nil {
+		return err
+	}
+	if _, ok := a.readOnlyMethods[fullMethod]; readOnly && !ok {
+		return status.Errorf(codes.PermissionDenied, "read-only token can't call %s", fullMethod)
+	}
+	return nil
+}
+
+// UnaryInterceptor rejects the unary RPCs without a token allowed to call them
+func (a *Authenticator) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := a.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor rejects the streaming RPCs without a token allowed to call them
+func (a *Authenticator) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// HTTPHandler wraps [handler], e.g. one served by a gateway alongside
+// the RPCs, so that it needs a token too. Read-only tokens are accepted.
+func (a *Authenticator) HTTPHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := a.CheckToken(r.Header.Get(AuthorizationKey)); err != nil {
+			http.Error(w, status.Convert(err).Message(), http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// ServerTLSConfig returns the TLS config of a server presenting the cert
+// of [certFile] and [keyFile], which requires client certs signed by a CA
+// of PEM file [clientCAFile], if given (mTLS).
+// Returns nil if TLS isn't enabled.
+func ServerTLSConfig(certFile string, keyFile string, clientCAFile string) (*tls.Config, error) {
+	if certFile == "" && keyFile == "" {
+		if clientCAFile != "" {
+			return nil, ErrInvalidTLSConfig
+		}
+		return nil, nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, ErrInvalidTLSConfig
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't load TLS cert: %w", err)
+	}
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile == "" {
+		return tlsCfg, nil
+	}
+
+	caPEM, err := os.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read TLS client CA file: %w", err)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no cert found in TLS client CA file %s", clientCAFile)
+	}
+	tlsCfg.ClientCAs = clientCAs
+	tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	return tlsCfg, nil
+}
+
+// ClientConfig is how a client authenticates to a server
+type ClientConfig struct {
+	// If true, the server is dialed over TLS. Implied by the TLS files.
+	TLS bool
+	// PEM file of the CAs the cert of the server is verified with.
+	// The CAs of the system are used if empty.
+	TLSCAFile string
+	// Cert presented to servers that require one (mTLS)
+	TLSCertFile string
+	TLSKeyFile  string
+	// If set, the name the cert of the server is verified for,
+	// instead of the host of the endpoint
+	TLSServerName string
+	// If set, sent as a bearer token with every request
+	AuthToken string
+}
+
+// DialOptions returns the options to dial the server with
+func (cfg ClientConfig) DialOptions() ([]grpc.DialOption, error) {
+	creds, err := cfg.transportCredentials()
+	if err != nil {
+		return nil, err
+	}
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if cfg.AuthToken != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(bearerToken(cfg.AuthToken)))
+	}
+	return dialOpts, nil
+}
+
+// transportCredentials returns the credentials to dial the server with
+func (cfg ClientConfig) transportCredentials() (credentials.TransportCredentials, error) {
+	if !cfg.TLS && cfg.TLSCAFile == "" && cfg.TLSCertFile == "" && cfg.TLSKeyFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+	tlsCfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.TLSServerName,
+	}
+	if cfg.TLSCAFile != "" {
+		caPEM, err := os.ReadFile(cfg.TLSCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read TLS CA file: %w", err)
+		}
+		tlsCfg.RootCAs = x509.NewCertPool()
+		if !tlsCfg.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no cert found in TLS CA file %s", cfg.TLSCAFile)
+		}
+	}
+	if cfg.TLSCertFile != "" || cfg.TLSKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't load TLS cert: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// bearerToken sends a token in the authorization metadata of the requests
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{AuthorizationKey: bearerPrefix + string(t)}, nil
+}
+
+// The token may be sent in plaintext, e.g. to a server on the same host
+func (t bearerToken) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,49 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package grpcauth
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerTLSConfig(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+
+	tlsCfg, err := ServerTLSConfig("", "", "")
+	assert.NoError(err)
+	assert.Nil(tlsCfg)
+	_, err = ServerTLSConfig("", "", filepath.Join(dir, "ca.pem"))
+	assert.ErrorIs(err, ErrInvalidTLSConfig)
+	_, err = ServerTLSConfig(filepath.Join(dir, "cert.pem"), "", "")
+	assert.ErrorIs(err, ErrInvalidTLSConfig)
+	_, err = ServerTLSConfig(filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem"), "")
+	assert.Error(err)
+}
+
+func TestClientConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	dialOpts, err := ClientConfig{}.DialOptions()
+	assert.NoError(err)
+	assert.Len(dialOpts, 1)
+	dialOpts, err = ClientConfig{TLS: true, AuthToken: "admin"}.DialOptions()
+	assert.NoError(err)
+	assert.Len(dialOpts, 2)
+	_, err = ClientConfig{TLSCAFile: filepath.Join(t.TempDir(), "ca.pem")}.DialOptions()
+	assert.Error(err)
+
+	// The token is accepted by an authenticator
+	md, err := bearerToken("admin").GetRequestMetadata(context.Background())
+	assert.NoError(err)
+	auth, err := NewAuthenticator([]string{"admin"}, nil, nil)
+	assert.NoError(err)
+	readOnly, err := auth.CheckToken(md[AuthorizationKey])
+	assert.NoError(err)
+	assert.False(readOnly)
+}
@@ -0,0 +1,281 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanche-network-runner/spec"
+	"go.uber.org/zap"
+)
+
+// Actions of the steps of an apply, executed in this order
+const (
+	applyActionRemove  = "remove"
+	applyActionRestart = "restart"
+	applyActionAdd     = "add"
+)
+
+// Statuses of the steps of an apply
+const (
+	applyStatusPlanned   = "planned"
+	applyStatusSucceeded = "succeeded"
+	applyStatusFailed    = "failed"
+)
+
+// applyStep is a step of the plan of an apply
+type applyStep struct {
+	step *rpcpb.ApplyStep
+	// config the node is restarted or added with
+	nodeConfig node.Config
+	// info of an added node
+	nodeInfo *rpcpb.NodeInfo
+	// true if an added node gets the next staking identity of the network
+	newIdentity bool
+}
+
+// planApply returns the steps changing the nodes of the network to the ones
+// of [networkSpec], built into [nw]: the nodes not in the spec are removed,
+// the nodes whose config or binary changed are restarted, and the nodes
+// of the spec not in the network are added under [rootDataDir].
+// The genesis, plugin dir, custom VMs and whitelisted subnets of the network
+// aren't changed, and nodes keep their staking identity and C-Chain config
+// unless the spec gives them.
+func (lc *localNetwork) planApply(networkSpec *spec.Spec, nw *spec.Network, rootDataDir string) ([]*applyStep, error) {
+	currentConfigs := make(map[string]node.Config, len(lc.cfg.NodeConfigs))
+	for _, nodeConfig := range lc.cfg.NodeConfigs {
+		currentConfigs[nodeConfig.Name] = nodeConfig
+	}
+	specNodeNames := make(map[string]struct{}, len(nw.Config.NodeConfigs))
+	for _, nodeConfig := range nw.Config.NodeConfigs {
+		specNodeNames[nodeConfig.Name] = struct{}{}
+	}
+
+	logLevel := networkSpec.LogLevel
+	if logLevel == "" {
+		logLevel = lc.options.logLevel
+	}
+	if logLevel == "" {
+		logLevel = "INFO"
+	}
+	// nodes are added with the subnets the others validate,
+	// which include the ones of the custom VMs once installed
+	whitelistedSubnets := lc.options.whitelistedSubnets
+	if len(lc.nodeNames) > 0 {
+		if nodeInfo, ok := lc.nodeInfos[lc.nodeNames[0]]; ok {
+			whitelistedSubnets = nodeInfo.WhitelistedSubnets
+		}
+	}
+
+	var removes, restarts, adds []*applyStep
+	for _, name := range lc.nodeNames {
+		if _, ok := specNodeNames[name]; !ok {
+			removes = append(removes, &applyStep{
+				step: &rpcpb.ApplyStep{Action: applyActionRemove, NodeName: name, Reason: "not in spec"},
+			})
+		}
+	}
+	for i, nodeConfig := range nw.Config.NodeConfigs {
+		specNode := networkSpec.Nodes[i]
+		nodeConfig.Flags = mergeFlags(nw.Config.Flags, nodeConfig.Flags)
+		nodeConfig.RedirectStdout = true
+		nodeConfig.RedirectStderr = true
+
+		currentConfig, ok := currentConfigs[nodeConfig.Name]
+		nodeInfo, hasInfo := lc.nodeInfos[nodeConfig.Name]
+		if !ok || !hasInfo {
+			logDir := filepath.Join(rootDataDir, nodeConfig.Name, "log")
+			dbDir := filepath.Join(rootDataDir, nodeConfig.Name, "db-dir")
+			configFile, err := newNodeConfigFile(nodeConfig.ConfigFile, logLevel, logDir, dbDir, lc.options.pluginDir, whitelistedSubnets)
+			if err != nil {
+				return nil, fmt.Errorf("node %q: %w", nodeConfig.Name, err)
+			}
+			nodeConfig.ConfigFile = configFile
+			adds = append(adds, &applyStep{
+				step:       &rpcpb.ApplyStep{Action: applyActionAdd, NodeName: nodeConfig.Name, Reason: "not in network"},
+				nodeConfig: nodeConfig,
+				nodeInfo: &rpcpb.NodeInfo{
+					Name:               nodeConfig.Name,
+					ExecPath:           nodeConfig.BinaryPath,
+					LogDir:             logDir,
+					DbDir:              dbDir,
+					PluginDir:          lc.options.pluginDir,
+					WhitelistedSubnets: whitelistedSubnets,
+					Config:             []byte(configFile),
+				},
+				newIdentity: specNode.StakingKey == "",
+			})
+			continue
+		}
+
+		configFile, err := newNodeConfigFile(nodeConfig.ConfigFile, logLevel, nodeInfo.LogDir, nodeInfo.DbDir, lc.options.pluginDir, nodeInfo.WhitelistedSubnets)
+		if err != nil {
+			return nil, fmt.Errorf("node %q: %w", nodeConfig.Name, err)
+		}
+		nodeConfig.ConfigFile = configFile
+		if specNode.StakingKey == "" {
+			nodeConfig.StakingKey = currentConfig.StakingKey
+			nodeConfig.StakingCert = currentConfig.StakingCert
+		}
+		if _, ok := specNode.ChainConfigs["C"]; !ok {
+			nodeConfig.CChainConfigFile = currentConfig.CChainConfigFile
+		}
+		changes, err := nodeConfigChanges(currentConfig, nodeConfig, lc.cfg.Flags)
+		if err != nil {
+			return nil, fmt.Errorf("node %q: %w", nodeConfig.Name, err)
+		}
+		if len(changes) > 0 {
+			restarts = append(restarts, &applyStep{
+				step: &rpcpb.ApplyStep{
+					Action:   applyActionRestart,
+					NodeName: nodeConfig.Name,
+					Reason:   "changed " + strings.Join(changes, ", "),
+				},
+				nodeConfig: nodeConfig,
+			})
+		}
+	}
+	steps := append(removes, restarts...)
+	return append(steps, adds...), nil
+}
+
+// executeApply executes [steps] in order, setting their status.
+// A failed step doesn't stop the next ones.
+func (lc *localNetwork) executeApply(steps []*applyStep) {
+	for _, step := range steps {
+		zap.L().Info("applying step",
+			zap.String("action", step.step.Action),
+			zap.String("node-name", step.step.NodeName),
+			zap.String("reason", step.step.Reason),
+		)
+		if err := lc.executeApplyStep(step); err != nil {
+			zap.L().Warn("apply step failed",
+				zap.String("action", step.step.Action),
+				zap.String("node-name", step.step.NodeName),
+				zap.Error(err),
+			)
+			step.step.Status = applyStatusFailed
+			step.step.Error = err.Error()
+			continue
+		}
+		step.step.Status = applyStatusSucceeded
+	}
+}
+
+func (lc *localNetwork) executeApplyStep(step *applyStep) error {
+	name := step.step.NodeName
+	switch step.step.Action {
+	case applyActionRemove:
+		if err := lc.nw.RemoveNode(name); err != nil {
+			return err
+		}
+		lc.forgetNode(name)
+	case applyActionRestart:
+		if err := lc.nw.RemoveNode(name); err != nil {
+			return err
+		}
+		if _, err := lc.nw.AddNode(step.nodeConfig); err != nil {
+			lc.forgetNode(name)
+			return fmt.Errorf("node removed, but not added back: %w", err)
+		}
+		for i := range lc.cfg.NodeConfigs {
+			if lc.cfg.NodeConfigs[i].Name == name {
+				lc.cfg.NodeConfigs[i] = step.nodeConfig
+			}
+		}
+		lc.nodeInfos[name].ExecPath = step.nodeConfig.BinaryPath
+		lc.nodeInfos[name].Config = []byte(step.nodeConfig.ConfigFile)
+	case applyActionAdd:
+		nodeConfig := step.nodeConfig
+		if step.newIdentity {
+			stakingCert, stakingKey, err := lc.newStakingIdentity("")
+			if err != nil {
+				return fmt.Errorf("couldn't generate staking Cert/Key: %w", err)
+			}
+			nodeConfig.StakingKey = string(stakingKey)
+			nodeConfig.StakingCert = string(stakingCert)
+		}
+		if _, err := lc.nw.AddNode(nodeConfig); err != nil {
+			return err
+		}
+		lc.cfg.NodeConfigs = append(lc.cfg.NodeConfigs, nodeConfig)
+		lc.nodeNames = append(lc.nodeNames, name)
+		lc.nodeInfos[name] = step.nodeInfo
+	default:
+		return fmt.Errorf("unknown apply action %q", step.step.Action)
+	}
+	return nil
+}
+
+// newNodeConfigFile returns the config file of a node with
+// custom entries [customConfig], as set by newLocalNetwork
+func newNodeConfigFile(customConfig string, logLevel string, logDir string, dbDir string, pluginDir string, whitelistedSubnets string) (string, error) {
+	var defaultConfig map[string]interface{}
+	if err := json.Unmarshal([]byte(defaultNodeConfig), &defaultConfig); err != nil {
+		return "", err
+	}
+	mergedConfig, err := mergeNodeConfig(defaultConfig, nil, customConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed merging provided configs: %w", err)
+	}
+	return createConfigFileString(mergedConfig, logLevel, logDir, dbDir, pluginDir, whitelistedSubnets)
+}
+
+// nodeConfigChanges returns the fields of [current] that differ in
+// [desired], the flags of both being merged over [networkFlags]
+func nodeConfigChanges(current node.Config, desired node.Config, networkFlags map[string]interface{}) ([]string, error) {
+	changes := []string{}
+	if current.BinaryPath != desired.BinaryPath {
+		changes = append(changes, "binary")
+	}
+	if current.IsBeacon != desired.IsBeacon {
+		changes = append(changes, "beacon")
+	}
+	if (len(current.BootstrapNodes) > 0 || len(desired.BootstrapNodes) > 0) &&
+		!reflect.DeepEqual(current.BootstrapNodes, desired.BootstrapNodes) {
+		changes = append(changes, "bootstrap nodes")
+	}
+	var currentConfig, desiredConfig map[string]interface{}
+	if current.ConfigFile != "" {
+		if err := json.Unmarshal([]byte(current.ConfigFile), &currentConfig); err != nil {
+			return nil, fmt.Errorf("couldn't unmarshal config file: %w", err)
+		}
+	}
+	if err := json.Unmarshal([]byte(desired.ConfigFile), &desiredConfig); err != nil {
+		return nil, fmt.Errorf("couldn't unmarshal config file: %w", err)
+	}
+	if !reflect.DeepEqual(currentConfig, desiredConfig) {
+		changes = append(changes, "config")
+	}
+	if !reflect.DeepEqual(mergeFlags(networkFlags, current.Flags), mergeFlags(networkFlags, desired.Flags)) {
+		changes = append(changes, "flags")
+	}
+	if current.CChainConfigFile != desired.CChainConfigFile ||
+		(len(current.ChainConfigFiles) > 0 || len(desired.ChainConfigFiles) > 0) &&
+			!reflect.DeepEqual(current.ChainConfigFiles, desired.ChainConfigFiles) {
+		changes = append(changes, "chain configs")
+	}
+	if current.StakingKey != desired.StakingKey || current.StakingCert != desired.StakingCert {
+		changes = append(changes, "staking identity")
+	}
+	return changes, nil
+}
+
+// mergeFlags returns the flags of [base] overridden by the ones of [override]
+func mergeFlags(base map[string]interface{}, override map[string]interface{}) map[string]interface{} {
+	flags := make(map[string]interface{}, len(base)+len(override))
+	for k, v := range base {
+		flags[k] = v
+	}
+	for k, v := range override {
+		flags[k] = v
+	}
+	return flags
+}
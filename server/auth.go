@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/pkg/grpcauth"
+)
+
+// RPCs that the read-only tokens can call
+var readOnlyMethods = map[string]struct{}{
+	"/rpcpb.ControlService/Status":       {},
+	"/rpcpb.ControlService/Health":       {},
+	"/rpcpb.ControlService/URIs":         {},
+	"/rpcpb.ControlService/StreamStatus": {},
+	"/rpcpb.ControlService/ListNetworks": {},
+}
+
+// tlsConfigs returns the TLS config of the gRPC server and of the gateway
+// listener, and the one the gateway dials the gRPC server with.
+// Returns nils if TLS isn't enabled.
+func (cfg Config) tlsConfigs() (serverTLS *tls.Config, dialTLS *tls.Config, err error) {
+	serverTLS, err = grpcauth.ServerTLSConfig(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSClientCAFile)
+	if err != nil || serverTLS == nil {
+		return nil, nil, err
+	}
+	cert := serverTLS.Certificates[0]
+	// The gateway dials the gRPC server at its own address, which the cert
+	// may not be issued for, so it checks it's given the cert of the server
+	// instead of verifying the chain and the host
+	dialTLS = &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], cert.Certificate[0]) {
+				return errors.New("unexpected gRPC server cert")
+			}
+			return nil
+		},
+	}
+	if serverTLS.ClientCAs == nil {
+		return serverTLS, dialTLS, nil
+	}
+
+	// The gateway is authenticated by the HTTP clients' certs, and
+	// presents a cert of its own, trusted by this server only, to
+	// the gRPC server
+	gwCert, err := newGatewayCert()
+	if err != nil {
+		return nil, nil, err
+	}
+	serverTLS.ClientCAs.AddCert(gwCert.Leaf)
+	dialTLS.Certificates = []tls.Certificate{gwCert}
+	return serverTLS, dialTLS, nil
+}
+
+// newGatewayCert returns a self-signed client cert for the gateway.
+// It's a leaf cert, which can't sign other client certs.
+func newGatewayCert() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "network-runner-gateway"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().AddDate(10, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		// Not a CA, so that the certs signed with its key aren't trusted
+		IsCA: false,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}, nil
+}
@@ -0,0 +1,276 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/client"
+	"github.com/ava-labs/avalanche-network-runner/pkg/grpcauth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthenticator(t *testing.T) {
+	assert := assert.New(t)
+
+	auth, err := grpcauth.NewAuthenticator(nil, nil, readOnlyMethods)
+	assert.NoError(err)
+	assert.Nil(auth)
+	_, err = grpcauth.NewAuthenticator([]string{""}, nil, readOnlyMethods)
+	assert.ErrorIs(err, ErrEmptyAuthToken)
+
+	auth, err = grpcauth.NewAuthenticator([]string{"admin"}, []string{"viewer"}, readOnlyMethods)
+	assert.NoError(err)
+	call := func(token string, method string) codes.Code {
+		ctx := context.Background()
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(grpcauth.AuthorizationKey, "Bearer "+token))
+		}
+		_, err := auth.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			})
+		return status.Code(err)
+	}
+	assert.Equal(codes.Unauthenticated, call("", "/rpcpb.ControlService/Status"))
+	assert.Equal(codes.Unauthenticated, call("nope", "/rpcpb.ControlService/Status"))
+	assert.Equal(codes.OK, call("admin", "/rpcpb.ControlService/Stop"))
+	assert.Equal(codes.OK, call("viewer", "/rpcpb.ControlService/Status"))
+	assert.Equal(codes.OK, call("viewer", "/rpcpb.ControlService/StreamStatus"))
+	assert.Equal(codes.PermissionDenied, call("viewer", "/rpcpb.ControlService/Stop"))
+	assert.Equal(codes.PermissionDenied, call("viewer", "/rpcpb.ControlService/SendOutboundMessage"))
+
+	handler := auth.HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	for token, code := range map[string]int{"": http.StatusUnauthorized, "nope": http.StatusUnauthorized, "viewer": http.StatusOK} {
+		req := httptest.NewRequest(http.MethodGet, metricsPath, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		assert.Equal(code, recorder.Code, token)
+	}
+}
+
+// TestServerTLSAndAuth checks that the gRPC server and the gateway
+// require a client cert and a token
+func TestServerTLSAndAuth(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	caCert, caKey := newTestCert(t, dir, "ca", nil, nil)
+	newTestCert(t, dir, "server", caCert, caKey)
+	newTestCert(t, dir, "client", caCert, caKey)
+	file := func(name string) string { return filepath.Join(dir, name) }
+
+	_, err := New(Config{Port: ":1", GwPort: ":2", TLSClientCAFile: file("ca.pem")})
+	assert.ErrorIs(err, ErrInvalidTLSConfig)
+
+	port, gwPort := getFreePort(t), getFreePort(t)
+	s, err := New(Config{
+		Port:               port,
+		GwPort:             gwPort,
+		DialTimeout:        10 * time.Second,
+		SnapshotsDir:       t.TempDir(),
+		TLSCertFile:        file("server.pem"),
+		TLSKeyFile:         file("server-key.pem"),
+		TLSClientCAFile:    file("ca.pem"),
+		AuthTokens:         []string{"admin"},
+		ReadOnlyAuthTokens: []string{"viewer"},
+	})
+	assert.NoError(err)
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error)
+	go func() {
+		errc <- s.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-errc
+	}()
+
+	newClient := func(token string) (client.Client, error) {
+		return client.New(client.Config{
+			LogLevel:    "info",
+			Endpoint:    "127.0.0.1" + port,
+			DialTimeout: 2 * time.Second,
+			TLSCAFile:   file("ca.pem"),
+			TLSCertFile: file("client.pem"),
+			TLSKeyFile:  file("client-key.pem"),
+			AuthToken:   token,
+		})
+	}
+	admin, err := newClient("admin")
+	assert.NoError(err)
+	defer admin.Close()
+	_, err = admin.Ping(context.Background())
+	assert.NoError(err)
+	viewer, err := newClient("viewer")
+	assert.NoError(err)
+	defer viewer.Close()
+	_, err = viewer.Status(context.Background())
+	assert.NotEqual(codes.PermissionDenied, status.Code(err))
+	_, err = viewer.Stop(context.Background())
+	assert.Equal(codes.PermissionDenied, status.Code(err))
+	anonymous, err := newClient("")
+	assert.NoError(err)
+	defer anonymous.Close()
+	_, err = anonymous.Ping(context.Background())
+	assert.Equal(codes.Unauthenticated, status.Code(err))
+	// no client cert
+	_, err = client.New(client.Config{
+		LogLevel:    "info",
+		Endpoint:    "127.0.0.1" + port,
+		DialTimeout: time.Second,
+		TLSCAFile:   file("ca.pem"),
+		AuthToken:   "admin",
+	})
+	assert.Error(err)
+
+	// the gateway
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+	clientCert, err := tls.LoadX509KeyPair(file("client.pem"), file("client-key.pem"))
+	assert.NoError(err)
+	newHTTPClient := func(certs []tls.Certificate) *http.Client {
+		return &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			RootCAs:      roots,
+			Certificates: certs,
+		}}}
+	}
+	post := func(httpClient *http.Client, path string, token string) int {
+		req, err := http.NewRequest(http.MethodPost, "https://127.0.0.1"+gwPort+path, strings.NewReader("{}"))
+		assert.NoError(err)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return 0
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	httpClient := newHTTPClient([]tls.Certificate{clientCert})
+	defer httpClient.CloseIdleConnections()
+	// the gateway is served once it dialed the gRPC server
+	assert.Eventually(func() bool {
+		return post(httpClient, "/v1/ping", "admin") == http.StatusOK
+	}, 10*time.Second, 50*time.Millisecond)
+	assert.Equal(http.StatusUnauthorized, post(httpClient, "/v1/ping", ""))
+	assert.Equal(http.StatusForbidden, post(httpClient, "/v1/control/stop", "viewer"))
+
+	// no client cert
+	noCertClient := newHTTPClient(nil)
+	defer noCertClient.CloseIdleConnections()
+	assert.Zero(post(noCertClient, "/v1/ping", "admin"))
+}
+
+// TestGatewayCert checks that the cert of the gateway is trusted by the
+// gRPC server, but not the certs signed with its key
+func TestGatewayCert(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	caCert, caKey := newTestCert(t, dir, "ca", nil, nil)
+	newTestCert(t, dir, "server", caCert, caKey)
+	file := func(name string) string { return filepath.Join(dir, name) }
+
+	serverTLS, dialTLS, err := Config{
+		TLSCertFile:     file("server.pem"),
+		TLSKeyFile:      file("server-key.pem"),
+		TLSClientCAFile: file("ca.pem"),
+	}.tlsConfigs()
+	assert.NoError(err)
+	gwCert := dialTLS.Certificates[0]
+	assert.False(gwCert.Leaf.IsCA)
+	opts := x509.VerifyOptions{
+		Roots:     serverTLS.ClientCAs,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	_, err = gwCert.Leaf.Verify(opts)
+	assert.NoError(err)
+
+	forged, _ := newTestCert(t, dir, "forged", gwCert.Leaf, gwCert.PrivateKey.(*ecdsa.PrivateKey))
+	_, err = forged.Verify(opts)
+	assert.Error(err)
+}
+
+// newTestCert writes the cert and key of [name], signed by [parent], or
+// a CA if [parent] is nil, to [name].pem and [name]-key.pem under [dir]
+func newTestCert(
+	t *testing.T,
+	dir string,
+	name string,
+	parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey,
+) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	if err := os.WriteFile(filepath.Join(dir, name+".pem"), certPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name+"-key.pem"), keyPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+// getFreePort returns a free port, as ":port"
+func getFreePort(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return fmt.Sprintf(":%d", l.Addr().(*net.TCPAddr).Port)
+}
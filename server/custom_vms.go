@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ava-labs/avalanche-network-runner/network"
 	"github.com/ava-labs/avalanche-network-runner/pkg/color"
 	"github.com/ava-labs/avalanche-network-runner/rpcpb"
 	"github.com/ava-labs/avalanche-network-runner/utils"
@@ -91,6 +92,7 @@ func (lc *localNetwork) waitForCustomVMsReady(ctx context.Context) error {
 	println()
 	color.Outf("{{blue}}{{bold}}waiting for custom VMs to report healthy...{{/}}\n")
 
+	healthCheckStart := time.Now()
 	hc := lc.nw.Healthy(ctx)
 	select {
 	case <-lc.stopc:
@@ -102,6 +104,7 @@ func (lc *localNetwork) waitForCustomVMsReady(ctx context.Context) error {
 			return err
 		}
 	}
+	lc.options.metrics.observeHealthCheck(healthCheckStart)
 
 	for nodeName, nodeInfo := range lc.nodeInfos {
 		zap.L().Info("inspecting node log directory for custom VM logs",
@@ -153,6 +156,7 @@ func (lc *localNetwork) waitForCustomVMsReady(ctx context.Context) error {
 		println()
 		color.Outf("{{green}}{{bold}}all custom VMs are ready on RPC server-side -- network-runner RPC client can poll and query the cluster status{{/}}\n")
 		close(lc.customVMsReadyc)
+		lc.options.events.Publish(network.NewEvent(network.EventCustomVMsReady, "", ""))
 	})
 	return nil
 }
@@ -309,6 +313,11 @@ func (lc *localNetwork) createSubnets(ctx context.Context, baseWallet *refreshab
 			},
 			subnetID: subnetID,
 		}
+		lc.options.events.Publish(network.NewEvent(
+			network.EventSubnetCreated,
+			"",
+			fmt.Sprintf("subnet %s for VM %q", subnetID, vmName),
+		))
 	}
 	return nil
 }
@@ -454,6 +463,11 @@ func (lc *localNetwork) createBlockchains(ctx context.Context, baseWallet *refre
 			zap.String("vm-id", vmID.String()),
 			zap.String("blockchain-id", blockchainID.String()),
 		)
+		lc.options.events.Publish(network.NewEvent(
+			network.EventBlockchainCreated,
+			"",
+			fmt.Sprintf("blockchain %s for VM %q", blockchainID, vmName),
+		))
 	}
 	return nil
 }
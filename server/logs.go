@@ -0,0 +1,342 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	// Sources of the logs of the output of the node processes
+	logSourceStdout = "stdout"
+	logSourceStderr = "stderr"
+	// Extension of the avalanchego log files
+	logFileExt = ".log"
+	// Time between checks for lines appended to followed logs
+	logsPollInterval = 250 * time.Millisecond
+)
+
+// logFile is a log of a node sent by StreamLogs
+type logFile struct {
+	nodeName string
+	source   string
+	path     string
+}
+
+func (s *server) StreamLogs(req *rpcpb.StreamLogsRequest, stream rpcpb.ControlService_StreamLogsServer) error {
+	zap.L().Info("received stream logs request",
+		zap.Strings("node-names", req.NodeNames),
+		zap.Strings("sources", req.Sources),
+		zap.Bool("follow", req.Follow),
+	)
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return err
+	}
+	if info := entry.getClusterInfo(); info == nil {
+		return ErrNotBootstrapped
+	}
+
+	filter, err := newLogFilter(req.Level, req.Regex)
+	if err != nil {
+		return err
+	}
+	sources := req.Sources
+	if len(sources) == 0 {
+		sources = []string{logSourceStdout, logSourceStderr}
+	}
+	for _, source := range sources {
+		if source == "" || source == "." || source == ".." || filepath.Base(source) != source {
+			return fmt.Errorf("%w: %q", ErrInvalidLogSource, source)
+		}
+	}
+	logFiles, err := entry.getLogFiles(req.NodeNames, sources)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.rootCtx.Done():
+		case <-s.closed:
+		case <-ctx.Done():
+		}
+		cancel()
+	}()
+
+	linesCh := make(chan *rpcpb.LogLine)
+	wg := sync.WaitGroup{}
+	for _, f := range logFiles {
+		wg.Add(1)
+		go func(f logFile, filter logFilter) {
+			defer wg.Done()
+			err := tailLog(ctx, f.path, req.Follow, int(req.TailLines), &filter, func(line string) bool {
+				select {
+				case linesCh <- &rpcpb.LogLine{NodeName: f.nodeName, Source: f.source, Line: line}:
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			})
+			if err != nil {
+				zap.L().Warn("failed to read log", zap.String("path", f.path), zap.Error(err))
+			}
+		}(f, *filter)
+	}
+	go func() {
+		wg.Wait()
+		close(linesCh)
+	}()
+
+	for line := range linesCh {
+		if err := stream.Send(&rpcpb.StreamLogsResponse{Line: line}); err != nil {
+			cancel()
+			for range linesCh {
+			}
+			if isClientCanceled(stream.Context().Err(), err) {
+				zap.L().Debug("client stream canceled", zap.Error(err))
+				return nil
+			}
+			zap.L().Warn("failed to send a log line", zap.Error(err))
+			return err
+		}
+	}
+	if errors.Is(stream.Context().Err(), context.Canceled) {
+		return ErrLogsCanceled
+	}
+	return nil
+}
+
+// getLogFiles returns the logs of [sources] of nodes [nodeNames],
+// or of all the nodes if empty
+func (entry *networkEntry) getLogFiles(nodeNames []string, sources []string) ([]logFile, error) {
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	if len(nodeNames) == 0 {
+		nodeNames = entry.network.nodeNames
+	}
+	logFiles := []logFile{}
+	for _, nodeName := range nodeNames {
+		n, err := entry.network.nw.GetNode(nodeName)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrNodeNotFound, nodeName)
+		}
+		for _, source := range sources {
+			var path string
+			switch source {
+			case logSourceStdout:
+				path = filepath.Join(n.GetConfig().OutputLogs.Dir, node.StdoutLogFileName)
+			case logSourceStderr:
+				path = filepath.Join(n.GetConfig().OutputLogs.Dir, node.StderrLogFileName)
+			default:
+				path = filepath.Join(n.GetLogsDir(), source+logFileExt)
+			}
+			logFiles = append(logFiles, logFile{nodeName: nodeName, source: source, path: path})
+		}
+	}
+	return logFiles, nil
+}
+
+// tailLog calls [send] with the lines of the log at [path] matched by [filter],
+// until [send] returns false or the end of the log is reached.
+// If [tailLines] isn't 0, only the last [tailLines] lines are sent.
+// If [follow], the lines appended to the log are then sent until [ctx]
+// is done, and the log is reopened when it's rotated, or opened once
+// it's created if it doesn't exist yet.
+func tailLog(
+	ctx context.Context,
+	path string,
+	follow bool,
+	tailLines int,
+	filter *logFilter,
+	send func(line string) bool,
+) error {
+	var (
+		file   *os.File
+		reader *bufio.Reader
+		// read part of a line not ended yet
+		partial string
+		// matched lines, held until the end of the log is first reached
+		pending  []string
+		caughtUp bool
+		// true if the file was rotated, and is read to its end before reopening
+		rotated bool
+	)
+	defer func() {
+		if file != nil {
+			_ = file.Close()
+		}
+	}()
+	handle := func(line string) bool {
+		if !filter.match(line) {
+			return true
+		}
+		if caughtUp {
+			return send(line)
+		}
+		pending = append(pending, line)
+		if tailLines > 0 && len(pending) > tailLines {
+			pending = pending[1:]
+		}
+		return true
+	}
+
+	for {
+		if file == nil {
+			f, err := os.Open(path)
+			switch {
+			case err == nil:
+				file = f
+				reader = bufio.NewReader(f)
+			case !os.IsNotExist(err):
+				return err
+			case !follow:
+				return nil
+			}
+		}
+		if file != nil {
+			line, err := reader.ReadString('\n')
+			if err == nil {
+				if !handle(strings.TrimSuffix(partial+line, "\n")) {
+					return nil
+				}
+				partial = ""
+				continue
+			}
+			if !errors.Is(err, io.EOF) {
+				return err
+			}
+			partial += line
+			if rotated {
+				_ = file.Close()
+				file = nil
+				rotated = false
+				continue
+			}
+		}
+
+		// end of the log
+		if !follow && partial != "" {
+			handle(partial)
+		}
+		if !caughtUp {
+			caughtUp = true
+			for _, line := range pending {
+				if !send(line) {
+					return nil
+				}
+			}
+			pending = nil
+		}
+		if !follow {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(logsPollInterval):
+		}
+		if file != nil {
+			rotated = wasRotated(file, path)
+		}
+	}
+}
+
+// wasRotated returns true if the file at [path] isn't [file], read to
+// its current end, or if it's shorter than what was read of [file]
+func wasRotated(file *os.File, path string) bool {
+	pathInfo, err := os.Stat(path)
+	if err != nil {
+		return true
+	}
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return true
+	}
+	if !os.SameFile(fileInfo, pathInfo) {
+		return true
+	}
+	offset, err := file.Seek(0, io.SeekCurrent)
+	return err != nil || pathInfo.Size() < offset
+}
+
+// logFilter matches the lines of a log by level and regular expression
+type logFilter struct {
+	// Most verbose level matched. Off if lines aren't matched by level.
+	level logging.Level
+	// If nil, lines aren't matched by regular expression.
+	regex *regexp.Regexp
+	// Level of the last line with one
+	lastLevel logging.Level
+}
+
+func newLogFilter(level string, regex string) (*logFilter, error) {
+	filter := &logFilter{}
+	if level != "" {
+		var err error
+		filter.level, err = logging.ToLevel(level)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if regex != "" {
+		var err error
+		filter.regex, err = regexp.Compile(regex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex: %w", err)
+		}
+	}
+	return filter, nil
+}
+
+// match returns true if [line] is matched. Lines without
+// a level are matched by the level of the previous line.
+func (f *logFilter) match(line string) bool {
+	if f.level != logging.Off {
+		if lineLevel, ok := parseLogLevel(line); ok {
+			f.lastLevel = lineLevel
+		}
+		if f.lastLevel > f.level {
+			return false
+		}
+	}
+	return f.regex == nil || f.regex.MatchString(line)
+}
+
+// parseLogLevel returns the level of an avalanchego log line,
+// which starts with it, e.g. "INFO [08-15|10:41:23.123] ..."
+func parseLogLevel(line string) (logging.Level, bool) {
+	end := strings.IndexAny(line, " [")
+	if end < 0 {
+		return logging.Off, false
+	}
+	level, err := logging.ToLevel(line[:end])
+	if err != nil || level == logging.Off {
+		return logging.Off, false
+	}
+	return level, true
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	filter, err := newLogFilter("warn", "")
+	assert.NoError(err)
+	assert.True(filter.match("ERROR [08-15|10:41:23.123] <C Chain> failed"))
+	assert.True(filter.match("\tstack trace of the error"))
+	assert.False(filter.match("INFO [08-15|10:41:23.124] <P Chain> started"))
+	assert.False(filter.match("details of the info"))
+	assert.True(filter.match("WARN [08-15|10:41:23.125] slow"))
+
+	filter, err = newLogFilter("", "Chain> [a-z]+ed$")
+	assert.NoError(err)
+	assert.True(filter.match("INFO [08-15|10:41:23.124] <P Chain> started"))
+	assert.False(filter.match("WARN [08-15|10:41:23.125] slow"))
+
+	_, err = newLogFilter("loud", "")
+	assert.Error(err)
+	_, err = newLogFilter("", "(")
+	assert.Error(err)
+}
+
+func TestTailLog(t *testing.T) {
+	assert := assert.New(t)
+	path := filepath.Join(t.TempDir(), "stdout.log")
+	assert.NoError(os.WriteFile(path, []byte("line1\nline2\nline3\nline4"), 0o600))
+
+	// Without following, the last lines are sent, including an unended one
+	lines := []string{}
+	filter, err := newLogFilter("", "")
+	assert.NoError(err)
+	err = tailLog(context.Background(), path, false, 2, filter, func(line string) bool {
+		lines = append(lines, line)
+		return true
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"line3", "line4"}, lines)
+
+	// Appended lines are followed across rotations
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	linesCh := make(chan string, 10)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- tailLog(ctx, path, true, 1, filter, func(line string) bool {
+			linesCh <- line
+			return true
+		})
+	}()
+	receive := func() string {
+		select {
+		case line := <-linesCh:
+			return line
+		case <-time.After(5 * time.Second):
+			return "timeout"
+		}
+	}
+	assert.Equal("line3", receive())
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	assert.NoError(err)
+	_, err = f.WriteString("4\nline5\n")
+	assert.NoError(err)
+	assert.NoError(f.Close())
+	assert.Equal("line44", receive())
+	assert.Equal("line5", receive())
+	assert.NoError(os.Rename(path, path+".1"))
+	assert.NoError(os.WriteFile(path, []byte("line6\n"), 0o600))
+	assert.Equal("line6", receive())
+
+	cancel()
+	assert.NoError(<-errCh)
+}
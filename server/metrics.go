@@ -0,0 +1,290 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	metricsNamespace = "network_runner"
+	// Path the metrics are served at
+	metricsPath = "/metrics"
+	// Path of the metrics of an avalanchego node
+	nodeMetricsPath = "/ext/metrics"
+	// Label of the node of the metrics of the nodes
+	nodeLabel = "node"
+	// Time to wait for the metrics of a node
+	nodeMetricsTimeout = 5 * time.Second
+)
+
+// Phases of the start of a network
+const (
+	startPhaseCreate           = "create"
+	startPhaseHealthy          = "healthy"
+	startPhaseInstallCustomVMs = "install-custom-vms"
+	startPhaseCustomVMsReady   = "custom-vms-ready"
+)
+
+// metrics are the metrics of the network runner. They are served
+// along with the ones of the nodes of the network, with a node label.
+type metrics struct {
+	registry *prometheus.Registry
+
+	rpcRequests         *prometheus.CounterVec
+	rpcDuration         *prometheus.HistogramVec
+	healthCheckDuration prometheus.Histogram
+	startPhaseDuration  *prometheus.HistogramVec
+}
+
+// newMetrics returns the metrics of the network runner, where the
+// infos of the nodes of the networks are read from [getNodeInfos]
+func newMetrics(getNodeInfos func() map[string]*rpcpb.NodeInfo) (*metrics, error) {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		rpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "rpc_requests_total",
+			Help:      "Number of RPCs handled, by method and status code",
+		}, []string{"method", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "rpc_duration_seconds",
+			Help:      "Time taken to handle RPCs, by method",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 4, 10),
+		}, []string{"method"}),
+		healthCheckDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "health_check_duration_seconds",
+			Help:      "Time taken by the nodes of the network to report healthy",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 12),
+		}),
+		startPhaseDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "start_phase_duration_seconds",
+			Help:      "Time taken by the phases of the start of a network",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 12),
+		}, []string{"phase"}),
+	}
+	collectors := []prometheus.Collector{
+		m.rpcRequests,
+		m.rpcDuration,
+		m.healthCheckDuration,
+		m.startPhaseDuration,
+		&nodesCollector{getNodeInfos: getNodeInfos},
+	}
+	for _, collector := range collectors {
+		if err := m.registry.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// unaryInterceptor counts and times unary RPCs
+func (m *metrics) unaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	m.observeRPC(info.FullMethod, err, start)
+	return resp, err
+}
+
+// streamInterceptor counts and times streaming RPCs
+func (m *metrics) streamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	m.observeRPC(info.FullMethod, err, start)
+	return err
+}
+
+func (m *metrics) observeRPC(fullMethod string, err error, start time.Time) {
+	method := path.Base(fullMethod)
+	m.rpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+	m.rpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+// observeHealthCheck records the time the nodes took to report healthy,
+// since [start]. Does nothing if [m] is nil.
+func (m *metrics) observeHealthCheck(start time.Time) {
+	if m == nil {
+		return
+	}
+	m.healthCheckDuration.Observe(time.Since(start).Seconds())
+}
+
+// observeStartPhase records the time [phase] of the start of a
+// network took, since [start]. Does nothing if [m] is nil.
+func (m *metrics) observeStartPhase(phase string, start time.Time) {
+	if m == nil {
+		return
+	}
+	m.startPhaseDuration.WithLabelValues(phase).Observe(time.Since(start).Seconds())
+}
+
+// handler returns the handler serving the metrics of the network runner,
+// and the ones of the nodes of the networks read from [getNodeInfos]
+func (m *metrics) handler(getNodeInfos func() map[string]*rpcpb.NodeInfo) http.Handler {
+	gatherers := prometheus.Gatherers{
+		m.registry,
+		prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+			return gatherNodeMetrics(getNodeInfos()), nil
+		}),
+	}
+	return promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{
+		ErrorHandling: promhttp.ContinueOnError,
+	})
+}
+
+// nodesCollector collects the number of nodes of the network,
+// and the crashes and restarts of each
+type nodesCollector struct {
+	getNodeInfos func() map[string]*rpcpb.NodeInfo
+}
+
+var (
+	nodesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "nodes"),
+		"Number of nodes of the network",
+		nil, nil,
+	)
+	nodeCrashesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "node_crashes_total"),
+		"Number of times the process of a node exited without being stopped",
+		[]string{nodeLabel}, nil,
+	)
+	nodeRestartsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "node_restarts_total"),
+		"Number of times the process of a node was restarted by its restart policy",
+		[]string{nodeLabel}, nil,
+	)
+)
+
+func (c *nodesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- nodesDesc
+	ch <- nodeCrashesDesc
+	ch <- nodeRestartsDesc
+}
+
+func (c *nodesCollector) Collect(ch chan<- prometheus.Metric) {
+	nodeInfos := c.getNodeInfos()
+	ch <- prometheus.MustNewConstMetric(nodesDesc, prometheus.GaugeValue, float64(len(nodeInfos)))
+	for name, nodeInfo := range nodeInfos {
+		ch <- prometheus.MustNewConstMetric(nodeCrashesDesc, prometheus.CounterValue, float64(nodeInfo.Crashes), name)
+		ch <- prometheus.MustNewConstMetric(nodeRestartsDesc, prometheus.CounterValue, float64(nodeInfo.Restarts), name)
+	}
+}
+
+// gatherNodeMetrics returns the metrics of the nodes of [nodeInfos], each
+// with the label of its node. The nodes whose metrics can't be read are skipped.
+func gatherNodeMetrics(nodeInfos map[string]*rpcpb.NodeInfo) []*dto.MetricFamily {
+	nodeURIs := make(map[string]string, len(nodeInfos))
+	for name, nodeInfo := range nodeInfos {
+		if nodeInfo.Uri != "" {
+			nodeURIs[name] = nodeInfo.Uri
+		}
+	}
+
+	var (
+		lock     sync.Mutex
+		families = map[string]*dto.MetricFamily{}
+		wg       sync.WaitGroup
+	)
+	for name, uri := range nodeURIs {
+		wg.Add(1)
+		go func(name string, uri string) {
+			defer wg.Done()
+			nodeFamilies, err := getNodeMetrics(uri)
+			if err != nil {
+				zap.L().Debug("failed to get node metrics", zap.String("node-name", name), zap.Error(err))
+				return
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			for familyName, nodeFamily := range nodeFamilies {
+				for _, metric := range nodeFamily.Metric {
+					addNodeLabel(metric, name)
+				}
+				family, ok := families[familyName]
+				if !ok {
+					families[familyName] = nodeFamily
+					continue
+				}
+				// nodes on different binaries may disagree on the type
+				if family.GetType() == nodeFamily.GetType() {
+					family.Metric = append(family.Metric, nodeFamily.Metric...)
+				}
+			}
+		}(name, uri)
+	}
+	wg.Wait()
+
+	result := make([]*dto.MetricFamily, 0, len(families))
+	for _, family := range families {
+		result = append(result, family)
+	}
+	return result
+}
+
+// getNodeMetrics returns the metrics of the node at [uri], by name
+func getNodeMetrics(uri string) (map[string]*dto.MetricFamily, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), nodeMetricsTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri+nodeMetricsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q", resp.Status)
+	}
+	var parser expfmt.TextParser
+	return parser.TextToMetricFamilies(resp.Body)
+}
+
+// addNodeLabel adds the label of node [name] to [metric]. A label
+// of the node of the same name is renamed "exported_node".
+func addNodeLabel(metric *dto.Metric, name string) {
+	for _, label := range metric.Label {
+		if label.GetName() == nodeLabel {
+			label.Name = proto.String("exported_" + nodeLabel)
+		}
+	}
+	metric.Label = append(metric.Label, &dto.LabelPair{
+		Name:  proto.String(nodeLabel),
+		Value: proto.String(name),
+	})
+	sort.Slice(metric.Label, func(i, j int) bool {
+		return metric.Label[i].GetName() < metric.Label[j].GetName()
+	})
+}
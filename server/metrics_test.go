@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	newNode := func(metrics string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != nodeMetricsPath {
+				http.NotFound(w, r)
+				return
+			}
+			_, _ = io.WriteString(w, metrics)
+		}))
+	}
+	node1 := newNode("# TYPE avalanche_requests counter\navalanche_requests{chain=\"C\"} 3\n")
+	defer node1.Close()
+	node2 := newNode("# TYPE avalanche_requests counter\navalanche_requests{chain=\"C\",node=\"x\"} 5\n")
+	defer node2.Close()
+	nodeInfos := map[string]*rpcpb.NodeInfo{
+		"node1": {Name: "node1", Uri: node1.URL, Restarts: 2},
+		"node2": {Name: "node2", Uri: node2.URL},
+		// not ready yet
+		"node3": {Name: "node3"},
+	}
+	getNodeInfos := func() map[string]*rpcpb.NodeInfo { return nodeInfos }
+
+	m, err := newMetrics(getNodeInfos)
+	assert.NoError(err)
+	_, err = m.unaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/rpcpb.ControlService/Status"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.NotFound, "not found")
+		})
+	assert.Error(err)
+
+	recorder := httptest.NewRecorder()
+	m.handler(getNodeInfos).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, metricsPath, nil))
+	body := recorder.Body.String()
+	assert.Contains(body, `avalanche_requests{chain="C",node="node1"} 3`)
+	assert.Contains(body, `avalanche_requests{chain="C",exported_node="x",node="node2"} 5`)
+	assert.Contains(body, "network_runner_nodes 3")
+	assert.Contains(body, `network_runner_node_restarts_total{node="node1"} 2`)
+	assert.Contains(body, `network_runner_rpc_requests_total{code="NotFound",method="Status"} 1`)
+	assert.Contains(body, `network_runner_rpc_duration_seconds_count{method="Status"} 1`)
+}
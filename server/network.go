@@ -8,16 +8,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ava-labs/avalanche-network-runner/api"
 	"github.com/ava-labs/avalanche-network-runner/local"
 	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
 	"github.com/ava-labs/avalanche-network-runner/pkg/color"
 	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanche-network-runner/spec"
+	"github.com/ava-labs/avalanche-network-runner/utils"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/utils/constants"
@@ -57,6 +61,9 @@ type localNetwork struct {
 
 	options localNetworkOptions
 
+	// index of the staking identity of the next node added
+	nextIdentityIndex uint32
+
 	// maps from node name to peer ID to peer object
 	attachedPeers map[string]map[string]peer.Peer
 
@@ -91,6 +98,7 @@ type localNetworkOptions struct {
 	execPath           string
 	rootDataDir        string
 	numNodes           uint32
+	numBeacons         uint32
 	whitelistedSubnets string
 	logLevel           string
 	globalNodeConfig   string
@@ -99,8 +107,47 @@ type localNetworkOptions struct {
 	customVMs         map[string][]byte
 	customNodeConfigs map[string]string
 
+	// if true, nodes reach each other through proxies
+	p2pProxy bool
+
+	// if true, each node gets its own loopback IP
+	distinctNodeIPs bool
+
+	// seed of the staking identities of the nodes past the default ones
+	// and of the nodes added later; local.DefaultIdentitySeed if empty
+	identitySeed string
+
+	// if non-empty, JSON spec of the builder of the genesis
+	genesisSpec string
+	// if non-empty, JSON of the accounts added to the C-Chain genesis
+	cChainAllocations string
+
+	// if non-nil, config of the network built from a spec, instead of
+	// the default one
+	specConfig *network.Config
+
+	// time a node is given to exit once sent a SIGTERM;
+	// the default of the network if 0
+	stopGracePeriod time.Duration
+
+	// dir where network snapshots are saved and loaded from
+	snapshotsDir string
+	// if non-empty, the network is started from this snapshot
+	// instead of creating new nodes
+	snapshotName string
+
+	// where the network events are published
+	events *network.EventBus
+
 	// to block racey restart while installing custom VMs
 	restartMu *sync.RWMutex
+
+	// where the start phases and health checks are timed, if non-nil
+	metrics *metrics
+
+	// if non-nil, the network is resumed from this state,
+	// saved by a server that exited without stopping it
+	state *networkState
 }
 
 func newLocalNetwork(opts localNetworkOptions) (*localNetwork, error) {
@@ -116,11 +163,76 @@ func newLocalNetwork(opts localNetworkOptions) (*localNetwork, error) {
 	if logLevel == "" {
 		logLevel = "INFO"
 	}
+	if opts.events == nil {
+		opts.events = &network.EventBus{}
+	}
 
-	nodeInfos := make(map[string]*rpcpb.NodeInfo)
-	cfg, err := local.NewDefaultConfigNNodes(opts.execPath, opts.numNodes)
-	if err != nil {
-		return nil, err
+	lc := &localNetwork{
+		logger: logger,
+
+		binPath: opts.execPath,
+
+		options: opts,
+
+		nodeInfos:     make(map[string]*rpcpb.NodeInfo),
+		apiClis:       make(map[string]api.Client),
+		attachedPeers: make(map[string]map[string]peer.Peer),
+
+		localClusterReadyc: make(chan struct{}),
+
+		customVMNameToGenesis: opts.customVMs,
+		customVMIDToInfo:      make(map[ids.ID]vmInfo),
+		customVMsReadyc:       make(chan struct{}),
+		customVMRestartMu:     opts.restartMu,
+
+		stopc:      make(chan struct{}),
+		startDonec: make(chan struct{}),
+		startErrc:  make(chan error, 1),
+	}
+	if opts.snapshotName != "" {
+		// node configs and infos are read from the snapshot on start
+		return lc, nil
+	}
+	if opts.state != nil {
+		if err := lc.restoreState(opts.state); err != nil {
+			return nil, err
+		}
+		return lc, nil
+	}
+
+	if lc.options.identitySeed == "" {
+		lc.options.identitySeed = local.DefaultIdentitySeed
+	}
+	var cfg network.Config
+	if opts.specConfig != nil {
+		cfg = *opts.specConfig
+	} else {
+		// all the nodes are beacons if not given
+		numBeacons := opts.numBeacons
+		if numBeacons == 0 {
+			numBeacons = opts.numNodes
+		}
+		cfg, err = local.NewDefaultConfigNNodesAndBeaconsFromSeed(opts.execPath, opts.numNodes, numBeacons, lc.options.identitySeed)
+		if err != nil {
+			return nil, err
+		}
+		cfg.P2PProxy = opts.p2pProxy
+		cfg.DistinctNodeIPs = opts.distinctNodeIPs
+		if opts.genesisSpec != "" {
+			cfg.Genesis, err = buildGenesis(opts.genesisSpec, cfg)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if opts.stopGracePeriod != 0 {
+		cfg.StopGracePeriod = opts.stopGracePeriod
+	}
+	if opts.cChainAllocations != "" {
+		cfg.CChainAllocations, err = network.ParseCChainAllocations([]byte(opts.cChainAllocations))
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	var defaultConfig, globalConfig map[string]interface{}
@@ -138,13 +250,19 @@ func newLocalNetwork(opts localNetworkOptions) (*localNetwork, error) {
 	for i := range cfg.NodeConfigs {
 		// NOTE: Naming convention for node names is currently `node` + number, i.e. `node1,node2,node3,...node101`
 		nodeName := fmt.Sprintf("node%d", i+1)
+		customNodeConfig := opts.customNodeConfigs[nodeName]
+		if opts.specConfig != nil {
+			// the config file of a node of a spec has its custom entries
+			nodeName = cfg.NodeConfigs[i].Name
+			customNodeConfig = cfg.NodeConfigs[i].ConfigFile
+		}
 		logDir := filepath.Join(opts.rootDataDir, nodeName, "log")
 		dbDir := filepath.Join(opts.rootDataDir, nodeName, "db-dir")
 
 		nodeNames[i] = nodeName
 		cfg.NodeConfigs[i].Name = nodeName
 
-		mergedConfig, err := mergeNodeConfig(defaultConfig, globalConfig, opts.customNodeConfigs[nodeNames[i]])
+		mergedConfig, err := mergeNodeConfig(defaultConfig, globalConfig, customNodeConfig)
 		if err != nil {
 			return nil, fmt.Errorf("failed merging provided configs: %w", err)
 		}
@@ -154,13 +272,15 @@ func newLocalNetwork(opts localNetworkOptions) (*localNetwork, error) {
 			return nil, err
 		}
 
-		cfg.NodeConfigs[i].BinaryPath = opts.execPath
+		if cfg.NodeConfigs[i].BinaryPath == "" {
+			cfg.NodeConfigs[i].BinaryPath = opts.execPath
+		}
 		cfg.NodeConfigs[i].RedirectStdout = true
 		cfg.NodeConfigs[i].RedirectStderr = true
 
-		nodeInfos[nodeName] = &rpcpb.NodeInfo{
+		lc.nodeInfos[nodeName] = &rpcpb.NodeInfo{
 			Name:               nodeName,
-			ExecPath:           opts.execPath,
+			ExecPath:           cfg.NodeConfigs[i].BinaryPath,
 			Uri:                "",
 			Id:                 "",
 			LogDir:             logDir,
@@ -171,30 +291,24 @@ func newLocalNetwork(opts localNetworkOptions) (*localNetwork, error) {
 		}
 	}
 
-	return &localNetwork{
-		logger: logger,
-
-		binPath: opts.execPath,
-		cfg:     cfg,
-
-		options: opts,
-
-		nodeNames:     nodeNames,
-		nodeInfos:     nodeInfos,
-		apiClis:       make(map[string]api.Client),
-		attachedPeers: make(map[string]map[string]peer.Peer),
-
-		localClusterReadyc: make(chan struct{}),
-
-		customVMNameToGenesis: opts.customVMs,
-		customVMIDToInfo:      make(map[ids.ID]vmInfo),
-		customVMsReadyc:       make(chan struct{}),
-		customVMRestartMu:     opts.restartMu,
+	lc.cfg = cfg
+	lc.nodeNames = nodeNames
+	lc.nextIdentityIndex = uint32(len(cfg.NodeConfigs))
+	return lc, nil
+}
 
-		stopc:      make(chan struct{}),
-		startDonec: make(chan struct{}),
-		startErrc:  make(chan error, 1),
-	}, nil
+// buildGenesis returns the genesis built by the builder with JSON spec
+// [genesisSpec]. See spec.BuildGenesis.
+func buildGenesis(genesisSpec string, cfg network.Config) (string, error) {
+	var builder network.GenesisBuilder
+	if err := json.Unmarshal([]byte(genesisSpec), &builder); err != nil {
+		return "", fmt.Errorf("couldn't unmarshal genesis spec: %w", err)
+	}
+	genesis, err := spec.BuildGenesis(builder, cfg)
+	if err != nil {
+		return "", fmt.Errorf("invalid genesis spec: %w", err)
+	}
+	return genesis, nil
 }
 
 // mergeAndCheckForIgnores takes two maps, merging the two and overriding the first with the second
@@ -215,6 +329,12 @@ func mergeAndCheckForIgnores(base, override map[string]interface{}) {
 // customConfig: a custom config provided to be applied to this node. Overrides globalConfig and defaultConfig
 // returns final map of node config entries
 func mergeNodeConfig(baseConfig map[string]interface{}, globalConfig map[string]interface{}, customConfig string) (map[string]interface{}, error) {
+	// the base config is shared by all nodes, so it's copied
+	mergedConfig := make(map[string]interface{}, len(baseConfig))
+	for k, v := range baseConfig {
+		mergedConfig[k] = v
+	}
+	baseConfig = mergedConfig
 	mergeAndCheckForIgnores(baseConfig, globalConfig)
 
 	var jsonCustom map[string]interface{}
@@ -260,39 +380,237 @@ func (lc *localNetwork) start(ctx context.Context) {
 		close(lc.startDonec)
 	}()
 
-	color.Outf("{{blue}}{{bold}}create and run local network{{/}}\n")
-	nw, err := local.NewNetwork(lc.logger, lc.cfg, os.TempDir())
-	if err != nil {
-		lc.startErrc <- err
-		return
+	phaseStart := time.Now()
+	if lc.options.snapshotName != "" {
+		color.Outf("{{blue}}{{bold}}create and run local network from snapshot %q{{/}}\n", lc.options.snapshotName)
+		nw, err := local.NewNetworkFromSnapshot(
+			lc.logger,
+			lc.options.snapshotName,
+			lc.options.rootDataDir,
+			lc.options.snapshotsDir,
+			lc.options.execPath,
+			lc.options.events,
+		)
+		if err != nil {
+			lc.startFailed(err)
+			return
+		}
+		lc.nw = nw
+		if err := lc.loadNodeInfos(); err != nil {
+			lc.startFailed(err)
+			return
+		}
+	} else if lc.options.state != nil {
+		color.Outf("{{blue}}{{bold}}resume local network{{/}}\n")
+		nw, err := local.ResumeNetwork(lc.logger, lc.cfg, lc.options.rootDataDir, lc.options.snapshotsDir, lc.options.events)
+		if err != nil {
+			lc.startFailed(err)
+			return
+		}
+		lc.nw = nw
+	} else {
+		color.Outf("{{blue}}{{bold}}create and run local network{{/}}\n")
+		nw, err := local.NewNetwork(lc.logger, lc.cfg, lc.options.rootDataDir, lc.options.snapshotsDir, lc.options.events)
+		if err != nil {
+			lc.startFailed(err)
+			return
+		}
+		lc.nw = nw
 	}
-	lc.nw = nw
+	lc.options.metrics.observeStartPhase(startPhaseCreate, phaseStart)
 
-	if err := lc.waitForLocalClusterReady(ctx); err != nil {
-		lc.startErrc <- err
+	phaseStart = time.Now()
+	waitForReady := lc.waitForLocalClusterReady
+	if lc.options.state != nil {
+		waitForReady = lc.waitForResumedClusterReady
+	}
+	if err := waitForReady(ctx); err != nil {
+		lc.startFailed(err)
 		return
 	}
+	lc.options.metrics.observeStartPhase(startPhaseHealthy, phaseStart)
 
 	if len(lc.customVMNameToGenesis) == 0 {
 		color.Outf("{{orange}}{{bold}}custom VM not specified, skipping installation and its health checks...{{/}}\n")
 		return
 	}
+	phaseStart = time.Now()
 	if err := lc.installCustomVMs(ctx); err != nil {
-		lc.startErrc <- err
+		lc.startFailed(err)
 		return
 	}
+	lc.options.metrics.observeStartPhase(startPhaseInstallCustomVMs, phaseStart)
+	phaseStart = time.Now()
 	if err := lc.waitForCustomVMsReady(ctx); err != nil {
-		lc.startErrc <- err
+		lc.startFailed(err)
 		return
 	}
+	lc.options.metrics.observeStartPhase(startPhaseCustomVMsReady, phaseStart)
+}
+
+// loadNodeInfos rebuilds the node configs, names and infos
+// from the nodes running in [lc.nw] (e.g. after loading a snapshot).
+func (lc *localNetwork) loadNodeInfos() error {
+	nodes, err := lc.nw.GetAllNodes()
+	if err != nil {
+		return err
+	}
+	nodeNames := make([]string, 0, len(nodes))
+	for name := range nodes {
+		nodeNames = append(nodeNames, name)
+	}
+	sort.Strings(nodeNames)
+
+	lc.cfg.NodeConfigs = make([]node.Config, 0, len(nodes))
+	lc.nodeNames = nodeNames
+	lc.nextIdentityIndex = uint32(len(nodes))
+	for _, name := range nodeNames {
+		node := nodes[name]
+		nodeConfig := node.GetConfig()
+		lc.cfg.NodeConfigs = append(lc.cfg.NodeConfigs, nodeConfig)
+		if lc.binPath == "" {
+			lc.binPath = nodeConfig.BinaryPath
+		}
+
+		var configFile map[string]interface{}
+		if nodeConfig.ConfigFile != "" {
+			if err := json.Unmarshal([]byte(nodeConfig.ConfigFile), &configFile); err != nil {
+				return fmt.Errorf("couldn't unmarshal config file of node %q: %w", name, err)
+			}
+		}
+		pluginDir, _ := configFile["plugin-dir"].(string)
+		whitelistedSubnets, _ := configFile["whitelisted-subnets"].(string)
+
+		lc.nodeInfos[name] = &rpcpb.NodeInfo{
+			Name:               name,
+			ExecPath:           nodeConfig.BinaryPath,
+			Uri:                "",
+			Id:                 "",
+			LogDir:             node.GetLogsDir(),
+			DbDir:              node.GetDbDir(),
+			PluginDir:          pluginDir,
+			WhitelistedSubnets: whitelistedSubnets,
+			Config:             []byte(nodeConfig.ConfigFile),
+		}
+	}
+	return nil
+}
+
+// updateNodeInfos refreshes the process state, the health and the resource usage of
+// the node infos, and marks [clusterInfo] as unhealthy if a node process isn't running.
+// Does nothing until the network is ready.
+func (lc *localNetwork) updateNodeInfos(clusterInfo *rpcpb.ClusterInfo) {
+	select {
+	case <-lc.localClusterReadyc:
+	default:
+		return
+	}
+	nodes, err := lc.nw.GetAllNodes()
+	if err != nil {
+		return
+	}
+	healthReports, err := lc.nw.GetHealthReports()
+	if err != nil {
+		return
+	}
+	for name, node := range nodes {
+		nodeInfo, ok := lc.nodeInfos[name]
+		if !ok {
+			continue
+		}
+		status := node.GetProcessStatus()
+		nodeInfo.Running = status.Running
+		nodeInfo.Crashes = status.Crashes
+		nodeInfo.Restarts = status.Restarts
+		nodeInfo.LastExitCode = int32(status.ExitCode)
+		nodeInfo.LastExitSignal = status.ExitSignal
+		nodeInfo.LastExitTime = 0
+		if !status.ExitTime.IsZero() {
+			nodeInfo.LastExitTime = status.ExitTime.Unix()
+		}
+		if !status.Running {
+			clusterInfo.Healthy = false
+		}
+		if report, ok := healthReports[name]; ok {
+			nodeInfo.Health = newNodeHealth(report)
+		}
+		nodeInfo.NodeResources = newNodeResources(node.GetResourceUsage())
+		nodeConfig := node.GetConfig()
+		nodeInfo.IsBeacon = nodeConfig.IsBeacon
+		nodeInfo.BootstrapNodes = nodeConfig.BootstrapNodes
+	}
+}
+
+// newNodeHealth converts [report] to its RPC representation
+func newNodeHealth(report network.HealthReport) *rpcpb.NodeHealth {
+	nodeHealth := &rpcpb.NodeHealth{
+		Healthy:       report.Healthy,
+		FailingChecks: report.FailingChecks(),
+		Checks:        make(map[string]*rpcpb.HealthCheck, len(report.Checks)),
+		Error:         report.Error,
+	}
+	if !report.Time.IsZero() {
+		nodeHealth.Timestamp = report.Time.Unix()
+	}
+	for name, check := range report.Checks {
+		nodeHealth.Checks[name] = &rpcpb.HealthCheck{
+			Healthy:            check.Healthy,
+			Message:            check.Message,
+			Error:              check.Error,
+			ContiguousFailures: check.ContiguousFailures,
+		}
+	}
+	return nodeHealth
+}
+
+// startFailed reports that the network failed to start because of [err]
+func (lc *localNetwork) startFailed(err error) {
+	lc.options.events.Publish(network.NewEvent(network.EventStartFailed, "", err.Error()))
+	lc.startErrc <- err
 }
 
 var errAborted = errors.New("aborted")
 
 func (lc *localNetwork) waitForLocalClusterReady(ctx context.Context) error {
 	color.Outf("{{blue}}{{bold}}waiting for all nodes to report healthy...{{/}}\n")
+	return lc.waitForReady(ctx, lc.nw.Healthy(ctx))
+}
 
-	hc := lc.nw.Healthy(ctx)
+// waitForResumedClusterReady is waitForLocalClusterReady for a resumed
+// network, whose adopted nodes may be paused. As these can't become
+// healthy, only the other nodes are waited for.
+func (lc *localNetwork) waitForResumedClusterReady(ctx context.Context) error {
+	nodes, err := lc.nw.GetAllNodes()
+	if err != nil {
+		return err
+	}
+	nodeNames := make([]string, 0, len(nodes))
+	for name, node := range nodes {
+		if nodeInfo, ok := lc.nodeInfos[name]; ok {
+			nodeInfo.Paused = node.GetPaused()
+		}
+		if !node.GetPaused() {
+			nodeNames = append(nodeNames, name)
+		}
+	}
+	color.Outf("{{blue}}{{bold}}waiting for the nodes that aren't paused to report healthy...{{/}}\n")
+	return lc.waitForReady(ctx, lc.nw.WaitForNodes(ctx, nodeNames...))
+}
+
+// waitForReady waits for the nodes to be healthy, as reported on [hc],
+// then sets their infos and marks the network as ready
+func (lc *localNetwork) waitForReady(ctx context.Context, hc chan error) error {
+	if err := lc.waitForHealth(ctx, hc); err != nil {
+		return err
+	}
+	return lc.setReady(ctx)
+}
+
+// waitForHealth waits for the nodes to be healthy, as reported on [hc].
+// Unlike setReady, it doesn't access the infos of the network, so it
+// may be called without holding the lock of the network.
+func (lc *localNetwork) waitForHealth(ctx context.Context, hc chan error) error {
+	healthCheckStart := time.Now()
 	select {
 	case <-lc.stopc:
 		return errAborted
@@ -303,7 +621,13 @@ func (lc *localNetwork) waitForLocalClusterReady(ctx context.Context) error {
 			return err
 		}
 	}
+	lc.options.metrics.observeHealthCheck(healthCheckStart)
+	return nil
+}
 
+// setReady sets the infos of the nodes, once healthy,
+// and marks the network as ready
+func (lc *localNetwork) setReady(ctx context.Context) error {
 	nodes, err := lc.nw.GetAllNodes()
 	if err != nil {
 		return err
@@ -314,6 +638,10 @@ func (lc *localNetwork) waitForLocalClusterReady(ctx context.Context) error {
 
 		lc.nodeInfos[name].Uri = uri
 		lc.nodeInfos[name].Id = nodeID
+		// a paused node doesn't answer
+		if !node.GetPaused() {
+			lc.nodeInfos[name].Version = getNodeVersion(ctx, node)
+		}
 
 		lc.apiClis[name] = node.GetAPIClient()
 		color.Outf("{{cyan}}%s: node ID %q, URI %q{{/}}\n", name, nodeID, uri)
@@ -325,10 +653,80 @@ func (lc *localNetwork) waitForLocalClusterReady(ctx context.Context) error {
 	return nil
 }
 
+// forgetNode removes node [name], which was removed from the
+// network, from the node configs, names and infos
+func (lc *localNetwork) forgetNode(name string) {
+	delete(lc.nodeInfos, name)
+	delete(lc.apiClis, name)
+	nodeNames := make([]string, 0, len(lc.nodeNames))
+	for _, nodeName := range lc.nodeNames {
+		if nodeName != name {
+			nodeNames = append(nodeNames, nodeName)
+		}
+	}
+	lc.nodeNames = nodeNames
+	nodeConfigs := make([]node.Config, 0, len(lc.cfg.NodeConfigs))
+	for _, nodeConfig := range lc.cfg.NodeConfigs {
+		if nodeConfig.Name != name {
+			nodeConfigs = append(nodeConfigs, nodeConfig)
+		}
+	}
+	lc.cfg.NodeConfigs = nodeConfigs
+}
+
+// newStakingIdentity returns the staking cert and key of the next node
+// added to the network, derived from [seed], or from the seed of the
+// network if empty. Identities of nodes of the network are skipped.
+func (lc *localNetwork) newStakingIdentity(seed string) ([]byte, []byte, error) {
+	if seed == "" {
+		seed = lc.options.identitySeed
+	}
+	if seed == "" {
+		seed = local.DefaultIdentitySeed
+	}
+	nodes, err := lc.nw.GetAllNodes()
+	if err != nil {
+		return nil, nil, err
+	}
+	nodeIDs := make(map[ids.ShortID]struct{}, len(nodes))
+	for _, node := range nodes {
+		nodeIDs[node.GetNodeID()] = struct{}{}
+	}
+	for {
+		stakingCert, stakingKey, err := local.NewStakingIdentity(seed, lc.nextIdentityIndex)
+		if err != nil {
+			return nil, nil, err
+		}
+		lc.nextIdentityIndex++
+		nodeID, err := utils.ToNodeID(stakingKey, stakingCert)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := nodeIDs[nodeID]; !ok {
+			return stakingCert, stakingKey, nil
+		}
+	}
+}
+
+// getNodeVersion returns the version [node] reports,
+// or an empty string if it can't be queried
+func getNodeVersion(ctx context.Context, node node.Node) string {
+	reply, err := node.GetAPIClient().InfoAPI().GetNodeVersion(ctx)
+	if err != nil {
+		zap.L().Warn("couldn't get node version", zap.String("name", node.GetName()), zap.Error(err))
+		return ""
+	}
+	return reply.Version
+}
+
 func (lc *localNetwork) stop(ctx context.Context) {
 	lc.stopOnce.Do(func() {
 		close(lc.stopc)
-		serr := lc.nw.Stop(ctx)
+		var serr error
+		// nil if it failed to be created
+		if lc.nw != nil {
+			serr = lc.nw.Stop(ctx)
+		}
 		<-lc.startDonec
 		color.Outf("{{red}}{{bold}}terminated network{{/}} (error %v)\n", serr)
 	})
@@ -2,8 +2,13 @@ package server
 
 import (
 	"encoding/json"
+	"path/filepath"
 	"testing"
 
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanche-network-runner/spec"
+	"github.com/ava-labs/avalanche-network-runner/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -153,3 +158,114 @@ func TestEvalConfig(t *testing.T) {
 	assert.NotEqual(controlMap["staking-port"], float64(11111))
 	assert.NotEqual(controlMap["http-port"], float64(5555))
 }
+
+func TestBuildGenesis(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := local.NewDefaultConfig("avalanchego")
+	defaultNetworkID, err := utils.NetworkIDFromGenesis([]byte(cfg.Genesis))
+	assert.NoError(err)
+
+	// The network ID of the default genesis is kept, and all nodes are validators
+	genesis, err := buildGenesis(`{"message":"built"}`, cfg)
+	assert.NoError(err)
+	networkID, err := utils.NetworkIDFromGenesis([]byte(genesis))
+	assert.NoError(err)
+	assert.Equal(defaultNetworkID, networkID)
+	var unparsed map[string]interface{}
+	assert.NoError(json.Unmarshal([]byte(genesis), &unparsed))
+	assert.Equal("built", unparsed["message"])
+	assert.Len(unparsed["initialStakers"], len(cfg.NodeConfigs))
+
+	_, err = buildGenesis(`{"networkID":1}`, cfg)
+	assert.Error(err)
+	_, err = buildGenesis(`{`, cfg)
+	assert.Error(err)
+}
+
+func TestApplySpec(t *testing.T) {
+	assert := assert.New(t)
+
+	networkSpec := `{"version":1,"binary":"avalanchego","logLevel":"debug","nodes":[{"name":"beacon","beacon":true,"config":{"index-enabled":false}},{"binary":"other"}]}`
+	globalNodeConfig := `{"index-enabled":true}`
+	req := &rpcpb.StartRequest{
+		ExecPath:         "ignored",
+		Spec:             &networkSpec,
+		GlobalNodeConfig: &globalNodeConfig,
+	}
+	specConfig, err := applySpec(req)
+	assert.NoError(err)
+	assert.Equal("avalanchego", req.GetExecPath())
+	assert.EqualValues(2, req.GetNumNodes())
+	assert.Equal("DEBUG", req.GetLogLevel())
+	assert.Empty(req.GetGlobalNodeConfig())
+
+	// The nodes of the spec keep their names, binaries and configs
+	lc, err := newLocalNetwork(localNetworkOptions{
+		execPath:    req.GetExecPath(),
+		rootDataDir: t.TempDir(),
+		logLevel:    req.GetLogLevel(),
+		specConfig:  specConfig,
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"beacon", "node2"}, lc.nodeNames)
+	assert.Equal("other", lc.nodeInfos["node2"].ExecPath)
+	var configFile map[string]interface{}
+	assert.NoError(json.Unmarshal(lc.nodeInfos["beacon"].Config, &configFile))
+	assert.Equal(false, configFile["index-enabled"])
+	// custom entries of a node aren't applied to the others
+	assert.NoError(json.Unmarshal(lc.nodeInfos["node2"].Config, &configFile))
+	assert.Equal(true, configFile["index-enabled"])
+
+	invalidSpec := `{"version":1,"nodes":[{}]}`
+	req.Spec = &invalidSpec
+	_, err = applySpec(req)
+	assert.Error(err)
+	assert.Contains(err.Error(), "nodes[0].binary")
+}
+
+func TestPlanApply(t *testing.T) {
+	assert := assert.New(t)
+
+	rootDataDir := t.TempDir()
+	newSpec := func(specJSON string) (*spec.Spec, *spec.Network) {
+		networkSpec, err := spec.Parse([]byte(specJSON))
+		assert.NoError(err)
+		nw, err := networkSpec.Build()
+		assert.NoError(err)
+		return networkSpec, nw
+	}
+	networkSpec, nw := newSpec(`{"version":1,"binary":"avalanchego","nodes":[{"name":"beacon","beacon":true},{},{}]}`)
+	lc, err := newLocalNetwork(localNetworkOptions{
+		execPath:    "avalanchego",
+		rootDataDir: rootDataDir,
+		specConfig:  &nw.Config,
+	})
+	assert.NoError(err)
+
+	// Applying the spec of the network changes nothing
+	steps, err := lc.planApply(networkSpec, nw, rootDataDir)
+	assert.NoError(err)
+	assert.Empty(steps)
+
+	networkSpec, nw = newSpec(`{"version":1,"binary":"avalanchego","nodes":[` +
+		`{"name":"beacon","beacon":true},` +
+		`{"name":"node2","config":{"index-enabled":false}},` +
+		`{"name":"node4","binary":"other"}]}`)
+	steps, err = lc.planApply(networkSpec, nw, rootDataDir)
+	assert.NoError(err)
+	if assert.Len(steps, 3) {
+		assert.Equal(applyActionRemove, steps[0].step.Action)
+		assert.Equal("node3", steps[0].step.NodeName)
+		assert.Equal(applyActionRestart, steps[1].step.Action)
+		assert.Equal("node2", steps[1].step.NodeName)
+		assert.Equal("changed config", steps[1].step.Reason)
+		// the restarted node keeps its staking identity
+		assert.Equal(lc.cfg.NodeConfigs[1].StakingKey, steps[1].nodeConfig.StakingKey)
+		assert.Equal(applyActionAdd, steps[2].step.Action)
+		assert.Equal("node4", steps[2].step.NodeName)
+		assert.Equal("other", steps[2].nodeConfig.BinaryPath)
+		assert.True(steps[2].newIdentity)
+		assert.Equal(filepath.Join(rootDataDir, "node4", "log"), steps[2].nodeInfo.LogDir)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultNetworkName is the name of the network
+// of the requests that don't name one
+const DefaultNetworkName = "default"
+
+var networkNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// networkEntry is a network of the registry of the server. It's
+// registered on its first request, and kept once stopped, so that
+// its events can be watched across restarts.
+// The nodes of all the networks get their ports from the same
+// allocator (see local.portAllocator), so they never share one.
+type networkEntry struct {
+	name string
+
+	mu          *sync.RWMutex
+	clusterInfo *rpcpb.ClusterInfo
+	network     *localNetwork
+
+	// serializes the upgrades and applies, which release [mu]
+	// while waiting for the nodes to be healthy
+	opMu sync.Mutex
+
+	// events of the network (and of the following ones, if restarted)
+	events *network.EventBus
+}
+
+// getNetwork returns the network named [name], or the default
+// one if [name] is empty, registering it if needed
+func (s *server) getNetwork(name string) (*networkEntry, error) {
+	if name == "" {
+		name = DefaultNetworkName
+	}
+	if !networkNameRegex.MatchString(name) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidNetworkName, name)
+	}
+
+	s.networksMu.Lock()
+	defer s.networksMu.Unlock()
+
+	entry, ok := s.networks[name]
+	if !ok {
+		entry = &networkEntry{
+			name:   name,
+			mu:     new(sync.RWMutex),
+			events: &network.EventBus{},
+		}
+		s.networks[name] = entry
+	}
+	return entry, nil
+}
+
+// getNetworks returns the registered networks, sorted by name
+func (s *server) getNetworks() []*networkEntry {
+	s.networksMu.Lock()
+	defer s.networksMu.Unlock()
+
+	entries := make([]*networkEntry, 0, len(s.networks))
+	for _, entry := range s.networks {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+	return entries
+}
+
+// reserveRootDataDir records [rootDataDir] as the root data dir of network
+// [name], unless it's, or is nested with, the one of another network
+func (s *server) reserveRootDataDir(name string, rootDataDir string) error {
+	rootDataDir, err := filepath.Abs(rootDataDir)
+	if err != nil {
+		return err
+	}
+
+	s.networksMu.Lock()
+	defer s.networksMu.Unlock()
+
+	for otherName, otherDir := range s.rootDataDirs {
+		if otherName != name && (isSubDir(rootDataDir, otherDir) || isSubDir(otherDir, rootDataDir)) {
+			return fmt.Errorf("%w: %s is used by network %q", ErrRootDataDirInUse, rootDataDir, otherName)
+		}
+	}
+	s.rootDataDirs[name] = rootDataDir
+	return nil
+}
+
+// releaseRootDataDir forgets the root data dir of network [name]
+func (s *server) releaseRootDataDir(name string) {
+	s.networksMu.Lock()
+	defer s.networksMu.Unlock()
+
+	delete(s.rootDataDirs, name)
+}
+
+// isSubDir returns true if [dir] is [parent] or is under it.
+// Both must be absolute.
+func isSubDir(dir string, parent string) bool {
+	rel, err := filepath.Rel(parent, dir)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func (s *server) ListNetworks(ctx context.Context, req *rpcpb.ListNetworksRequest) (*rpcpb.ListNetworksResponse, error) {
+	zap.L().Debug("received list networks request")
+	resp := &rpcpb.ListNetworksResponse{
+		NetworkNames: []string{},
+		ClusterInfos: map[string]*rpcpb.ClusterInfo{},
+	}
+	for _, entry := range s.getNetworks() {
+		if info := entry.getClusterInfo(); info != nil {
+			resp.NetworkNames = append(resp.NetworkNames, entry.name)
+			resp.ClusterInfos[entry.name] = info
+		}
+	}
+	return resp, nil
+}
+
+func (s *server) StopAll(ctx context.Context, req *rpcpb.StopAllRequest) (*rpcpb.StopAllResponse, error) {
+	zap.L().Info("received stop all request")
+	resp := &rpcpb.StopAllResponse{NetworkNames: []string{}}
+	for _, entry := range s.getNetworks() {
+		if entry.getClusterInfo() == nil {
+			continue
+		}
+		if _, err := s.Stop(ctx, &rpcpb.StopRequest{NetworkName: entry.name}); err != nil {
+			// stopped meanwhile
+			if errors.Is(err, ErrNotBootstrapped) {
+				continue
+			}
+			return nil, err
+		}
+		resp.NetworkNames = append(resp.NetworkNames, entry.name)
+	}
+	return resp, nil
+}
+
+// getClusterInfo returns a copy of the cluster info of the
+// network, or nil if it isn't started
+func (entry *networkEntry) getClusterInfo() *rpcpb.ClusterInfo {
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.clusterInfo != nil && entry.network != nil {
+		entry.network.updateNodeInfos(entry.clusterInfo)
+	}
+	return entry.cloneClusterInfo()
+}
+
+// cloneClusterInfo returns a copy of the cluster info of the network,
+// or nil if it isn't started. Responses carry copies, as they're
+// marshalled once the lock is released.
+// Assumes [entry.mu] is held.
+func (entry *networkEntry) cloneClusterInfo() *rpcpb.ClusterInfo {
+	if entry.clusterInfo == nil {
+		return nil
+	}
+	return proto.Clone(entry.clusterInfo).(*rpcpb.ClusterInfo)
+}
+
+// getNodeInfos returns a copy of the infos of the nodes
+// of the network by name, or nil if it isn't started
+func (entry *networkEntry) getNodeInfos() map[string]*rpcpb.NodeInfo {
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.clusterInfo == nil || entry.network == nil {
+		return nil
+	}
+	entry.network.updateNodeInfos(entry.clusterInfo)
+	nodeInfos := make(map[string]*rpcpb.NodeInfo, len(entry.clusterInfo.NodeInfos))
+	for name, nodeInfo := range entry.clusterInfo.NodeInfos {
+		nodeInfos[name] = proto.Clone(nodeInfo).(*rpcpb.NodeInfo)
+	}
+	return nodeInfos
+}
+
+// getNodeInfos returns a copy of the infos of the nodes of all the
+// networks. The nodes of the default network are keyed by name, and
+// the ones of the other networks by "<network name>/<node name>".
+func (s *server) getNodeInfos() map[string]*rpcpb.NodeInfo {
+	nodeInfos := map[string]*rpcpb.NodeInfo{}
+	for _, entry := range s.getNetworks() {
+		for name, nodeInfo := range entry.getNodeInfos() {
+			if entry.name != DefaultNetworkName {
+				name = entry.name + "/" + name
+			}
+			nodeInfos[name] = nodeInfo
+		}
+	}
+	return nodeInfos
+}
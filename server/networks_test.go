@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanche-network-runner/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkRegistry(t *testing.T) {
+	assert := assert.New(t)
+	s := &server{
+		networks:     map[string]*networkEntry{},
+		rootDataDirs: map[string]string{},
+	}
+
+	defaultEntry, err := s.getNetwork("")
+	assert.NoError(err)
+	assert.Equal(DefaultNetworkName, defaultEntry.name)
+	entry, err := s.getNetwork(DefaultNetworkName)
+	assert.NoError(err)
+	assert.Same(defaultEntry, entry)
+	ciEntry, err := s.getNetwork("ci-job.1")
+	assert.NoError(err)
+	assert.NotSame(defaultEntry, ciEntry)
+	assert.NotSame(defaultEntry.events, ciEntry.events)
+	for _, name := range []string{"../up", "a/b", "-flag", " "} {
+		_, err = s.getNetwork(name)
+		assert.ErrorIs(err, ErrInvalidNetworkName, name)
+	}
+	names := []string{}
+	for _, entry := range s.getNetworks() {
+		names = append(names, entry.name)
+	}
+	assert.Equal([]string{"ci-job.1", DefaultNetworkName}, names)
+
+	// no network is started
+	_, err = s.Status(context.Background(), &rpcpb.StatusRequest{NetworkName: "ci-job.1"})
+	assert.ErrorIs(err, ErrNotBootstrapped)
+	resp, err := s.ListNetworks(context.Background(), &rpcpb.ListNetworksRequest{})
+	assert.NoError(err)
+	assert.Empty(resp.NetworkNames)
+	stopped, err := s.StopAll(context.Background(), &rpcpb.StopAllRequest{})
+	assert.NoError(err)
+	assert.Empty(stopped.NetworkNames)
+
+	// stopped between the check of the cluster info and the lock
+	entry.clusterInfo = &rpcpb.ClusterInfo{}
+	_, err = s.PauseNode(context.Background(), &rpcpb.PauseNodeRequest{Name: "node1"})
+	assert.ErrorIs(err, ErrNotBootstrapped)
+	_, err = s.SetFaults(context.Background(), &rpcpb.SetFaultsRequest{NodeName: "node1"})
+	assert.ErrorIs(err, ErrNotBootstrapped)
+	_, err = s.SaveSnapshot(context.Background(), &rpcpb.SaveSnapshotRequest{SnapshotName: "snapshot"})
+	assert.ErrorIs(err, ErrNotBootstrapped)
+	entry.clusterInfo = nil
+
+	dir := t.TempDir()
+	assert.NoError(s.reserveRootDataDir("a", filepath.Join(dir, "a")))
+	assert.NoError(s.reserveRootDataDir("b", filepath.Join(dir, "ab")))
+	assert.ErrorIs(s.reserveRootDataDir("c", filepath.Join(dir, "a")), ErrRootDataDirInUse)
+	assert.ErrorIs(s.reserveRootDataDir("c", filepath.Join(dir, "a", "c")), ErrRootDataDirInUse)
+	assert.ErrorIs(s.reserveRootDataDir("c", dir), ErrRootDataDirInUse)
+	// the same network may reuse its dir
+	assert.NoError(s.reserveRootDataDir("a", filepath.Join(dir, "a")))
+	s.releaseRootDataDir("a")
+	assert.NoError(s.reserveRootDataDir("c", filepath.Join(dir, "a", "c")))
+}
+
+// blockingNetwork is a network whose upgrades and health checks
+// close [startedCh], and last until [releaseCh] is closed
+type blockingNetwork struct {
+	network.Network
+	startedCh chan struct{}
+	releaseCh chan struct{}
+}
+
+func newBlockingNetwork() *blockingNetwork {
+	return &blockingNetwork{
+		startedCh: make(chan struct{}),
+		releaseCh: make(chan struct{}),
+	}
+}
+
+func (nw *blockingNetwork) RollingUpgrade(context.Context, string, network.UpgradeOptions) error {
+	close(nw.startedCh)
+	<-nw.releaseCh
+	return nil
+}
+
+func (nw *blockingNetwork) Healthy(context.Context) chan error {
+	errCh := make(chan error, 1)
+	go func() {
+		close(nw.startedCh)
+		<-nw.releaseCh
+		errCh <- nil
+	}()
+	return errCh
+}
+
+func (nw *blockingNetwork) RemoveNode(string) error {
+	return nil
+}
+
+func (nw *blockingNetwork) GetAllNodes() (map[string]node.Node, error) {
+	return map[string]node.Node{}, nil
+}
+
+// checkStatusWhile checks that the status of the network of [s] is
+// returned while [nw] blocks the request made by [call]
+func checkStatusWhile(t *testing.T, s *server, nw *blockingNetwork, call func() error) {
+	assert := assert.New(t)
+	errc := make(chan error)
+	go func() {
+		errc <- call()
+	}()
+	<-nw.startedCh
+	statusc := make(chan error)
+	go func() {
+		_, err := s.Status(context.Background(), &rpcpb.StatusRequest{})
+		statusc <- err
+	}()
+	select {
+	case err := <-statusc:
+		assert.NoError(err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("status blocked by the request")
+	}
+	close(nw.releaseCh)
+	assert.NoError(<-errc)
+}
+
+// TestRollingUpgradeStatus checks that the network
+// can be queried while its nodes are upgraded
+func TestRollingUpgradeStatus(t *testing.T) {
+	assert := assert.New(t)
+	s := &server{
+		networks:     map[string]*networkEntry{},
+		rootDataDirs: map[string]string{},
+	}
+	entry, err := s.getNetwork("")
+	assert.NoError(err)
+	rootDataDir := t.TempDir()
+	nw := newBlockingNetwork()
+	entry.network = &localNetwork{
+		nw:                 nw,
+		options:            localNetworkOptions{rootDataDir: rootDataDir},
+		localClusterReadyc: make(chan struct{}),
+	}
+	entry.clusterInfo = &rpcpb.ClusterInfo{RootDataDir: rootDataDir}
+	execPath := filepath.Join(t.TempDir(), "avalanchego")
+	assert.NoError(os.WriteFile(execPath, nil, 0o755))
+
+	checkStatusWhile(t, s, nw, func() error {
+		_, err := s.RollingUpgrade(context.Background(), &rpcpb.RollingUpgradeRequest{ExecPath: execPath})
+		return err
+	})
+}
+
+// TestApplyStatus checks that the network can be
+// queried while the applied nodes get healthy
+func TestApplyStatus(t *testing.T) {
+	assert := assert.New(t)
+	s := &server{
+		networks:     map[string]*networkEntry{},
+		rootDataDirs: map[string]string{},
+	}
+	entry, err := s.getNetwork("")
+	assert.NoError(err)
+	rootDataDir := t.TempDir()
+	networkSpec, err := spec.Parse([]byte(`{"version":1,"binary":"avalanchego","nodes":[{"name":"beacon","beacon":true},{}]}`))
+	assert.NoError(err)
+	specNetwork, err := networkSpec.Build()
+	assert.NoError(err)
+	lc, err := newLocalNetwork(localNetworkOptions{
+		execPath:    "avalanchego",
+		rootDataDir: rootDataDir,
+		specConfig:  &specNetwork.Config,
+	})
+	assert.NoError(err)
+	nw := newBlockingNetwork()
+	lc.nw = nw
+	entry.network = lc
+	entry.clusterInfo = &rpcpb.ClusterInfo{RootDataDir: rootDataDir}
+
+	var resp *rpcpb.ApplyResponse
+	checkStatusWhile(t, s, nw, func() error {
+		resp, err = s.Apply(context.Background(), &rpcpb.ApplyRequest{
+			Spec: `{"version":1,"binary":"avalanchego","nodes":[{"name":"beacon","beacon":true}]}`,
+		})
+		return err
+	})
+	if assert.Len(resp.Steps, 1) {
+		assert.Equal(applyActionRemove, resp.Steps[0].Action)
+		assert.Equal(applyStatusSucceeded, resp.Steps[0].Status)
+	}
+	assert.Equal([]string{"beacon"}, resp.ClusterInfo.NodeNames)
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ava-labs/avalanche-network-runner/local"
+)
+
+// ReapOrphans kills the node processes left running under [rootDirs] by
+// network runners that exited without stopping them, and under the root
+// dirs created in the temporary dir for networks not given one.
+// The root dirs under [skipRootDirs], e.g. the ones of networks about
+// to be resumed, are skipped.
+// If [dryRun], the orphans are only returned.
+// See local.ReapOrphans.
+func ReapOrphans(rootDirs []string, skipRootDirs []string, dryRun bool) ([]local.Orphan, error) {
+	rootDirs = append([]string{}, rootDirs...)
+	for _, pattern := range []string{rootDataDirPrefix + "*", local.TempRootDirPattern} {
+		tempRootDirs, err := filepath.Glob(filepath.Join(os.TempDir(), pattern))
+		if err != nil {
+			return nil, err
+		}
+		rootDirs = append(rootDirs, tempRootDirs...)
+	}
+	absSkipRootDirs := make([]string, 0, len(skipRootDirs))
+	for _, dir := range skipRootDirs {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, err
+		}
+		absSkipRootDirs = append(absSkipRootDirs, absDir)
+	}
+	reapedRootDirs := make([]string, 0, len(rootDirs))
+	for _, dir := range rootDirs {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, err
+		}
+		skip := false
+		for _, skipDir := range absSkipRootDirs {
+			if isSubDir(absDir, skipDir) {
+				skip = true
+				break
+			}
+		}
+		if !skip {
+			reapedRootDirs = append(reapedRootDirs, dir)
+		}
+	}
+	return local.ReapOrphans(reapedRootDirs, dryRun)
+}
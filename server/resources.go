@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"go.uber.org/zap"
+)
+
+func (s *server) GetResourceUsage(ctx context.Context, req *rpcpb.GetResourceUsageRequest) (*rpcpb.GetResourceUsageResponse, error) {
+	zap.L().Debug("received get resource usage request", zap.Strings("node-names", req.NodeNames))
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	if info := entry.getClusterInfo(); info == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	nodeNames := req.NodeNames
+	if len(nodeNames) == 0 {
+		nodeNames = entry.network.nodeNames
+	}
+	nodeResources := make(map[string]*rpcpb.NodeResources, len(nodeNames))
+	for _, nodeName := range nodeNames {
+		n, err := entry.network.nw.GetNode(nodeName)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrNodeNotFound, nodeName)
+		}
+		nodeResources[nodeName] = newNodeResources(n.GetResourceUsage())
+	}
+	return &rpcpb.GetResourceUsageResponse{NodeResources: nodeResources}, nil
+}
+
+// newNodeResources converts the resource usage [samples]
+// of a node, oldest first, to their RPC representation
+func newNodeResources(samples []node.ResourceUsage) *rpcpb.NodeResources {
+	nodeResources := &rpcpb.NodeResources{
+		History: make([]*rpcpb.ResourceUsage, len(samples)),
+	}
+	for i, sample := range samples {
+		nodeResources.History[i] = &rpcpb.ResourceUsage{
+			Timestamp:   sample.Time.Unix(),
+			CpuSeconds:  sample.CPUSeconds,
+			CpuPercent:  sample.CPUPercent,
+			RssBytes:    sample.RSSBytes,
+			Threads:     uint32(sample.Threads),
+			OpenFds:     uint32(sample.OpenFDs),
+			ReadBytes:   sample.ReadBytes,
+			WriteBytes:  sample.WriteBytes,
+			DbDirBytes:  sample.DBDirBytes,
+			LogDirBytes: sample.LogsDirBytes,
+		}
+	}
+	if len(samples) > 0 {
+		nodeResources.Current = nodeResources.History[len(samples)-1]
+	}
+	return nodeResources
+}
@@ -6,6 +6,7 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -21,17 +22,21 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/network"
 	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/pkg/grpcauth"
 	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanche-network-runner/spec"
 	"github.com/ava-labs/avalanche-network-runner/utils"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
-	"github.com/ava-labs/avalanchego/staking"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 )
@@ -40,6 +45,32 @@ type Config struct {
 	Port        string
 	GwPort      string
 	DialTimeout time.Duration
+	// Dir where network snapshots are saved and loaded from.
+	// Defaults to [DefaultSnapshotsDir].
+	SnapshotsDir string
+	// If non-empty, the metrics served at /metrics of the gRPC
+	// gateway are also served at /metrics of this port.
+	MetricsPort string
+	// Time a node is given to exit once sent a SIGTERM, before it's
+	// sent a SIGKILL. If 0, a default is used.
+	// See network.Config.StopGracePeriod.
+	StopGracePeriod time.Duration
+	// If set, the gRPC server and the gateway serve TLS with this cert.
+	TLSCertFile string
+	TLSKeyFile  string
+	// If set along with the cert, clients must present a cert
+	// signed by a CA of this PEM file (mTLS).
+	TLSClientCAFile string
+	// If any is non-empty, requests must carry one of these tokens
+	// in an "authorization: Bearer <token>" metadata entry, or
+	// header of the gateway. The metrics of the gateway need one too.
+	AuthTokens []string
+	// Tokens that can only call Status, Health, URIs, StreamStatus
+	// and ListNetworks.
+	ReadOnlyAuthTokens []string
+	// Root data dirs of networks left running by servers that exited,
+	// resumed before serving. See Resume.
+	ResumeRootDataDirs []string
 }
 
 type Server interface {
@@ -56,13 +87,22 @@ type server struct {
 	ln               net.Listener
 	gRPCServer       *grpc.Server
 	gRPCRegisterOnce sync.Once
+	// nil if TLS isn't enabled
+	gwDialTLS *tls.Config
 
 	gwMux    *runtime.ServeMux
 	gwServer *http.Server
 
-	mu          *sync.RWMutex
-	clusterInfo *rpcpb.ClusterInfo
-	network     *localNetwork
+	metrics *metrics
+	// nil if the metrics are only served by the gRPC gateway
+	metricsServer *http.Server
+
+	// guards [networks] and [rootDataDirs]. Each network has its own lock,
+	// which may be held when this one is acquired, but not the reverse.
+	networksMu sync.Mutex
+	networks   map[string]*networkEntry
+	// root data dir of each started network, by name
+	rootDataDirs map[string]string
 
 	rpcpb.UnimplementedPingServiceServer
 	rpcpb.UnimplementedControlServiceServer
@@ -75,12 +115,25 @@ var (
 	ErrPluginDirEmptyButCustomVMsNotEmpty = errors.New("empty plugin-dir but non-empty custom VMs")
 	ErrPluginDirNonEmptyButCustomVMsEmpty = errors.New("non-empty plugin-dir but empty custom VM")
 	ErrNotEnoughNodesForStart             = errors.New("not enough nodes specified for start")
+	ErrInvalidNumBeacons                  = errors.New("number of beacons must be between 1 and the number of nodes")
 	ErrAlreadyBootstrapped                = errors.New("already bootstrapped")
 	ErrNotBootstrapped                    = errors.New("not bootstrapped")
 	ErrNodeNotFound                       = errors.New("node not found")
 	ErrPeerNotFound                       = errors.New("peer not found")
 	ErrUnexpectedType                     = errors.New("unexpected type")
 	ErrStatusCanceled                     = errors.New("gRPC stream status canceled")
+	ErrSnapshotNotFound                   = errors.New("snapshot not found")
+	ErrInvalidEventType                   = errors.New("invalid event type")
+	ErrEventsCanceled                     = errors.New("gRPC stream events canceled")
+	ErrInvalidLogSource                   = errors.New("invalid log source")
+	ErrLogsCanceled                       = errors.New("gRPC stream logs canceled")
+	ErrInvalidTLSConfig                   = grpcauth.ErrInvalidTLSConfig
+	ErrEmptyAuthToken                     = grpcauth.ErrEmptyToken
+	ErrInvalidNetworkName                 = errors.New("invalid network name")
+	ErrRootDataDirInUse                   = errors.New("root data dir in use by another network")
+	ErrStateNotFound                      = errors.New("network state not found")
+
+	DefaultSnapshotsDir = filepath.Join(os.TempDir(), "avalanche-network-runner-snapshots")
 )
 
 const (
@@ -88,32 +141,85 @@ const (
 	DefaultNodes uint32 = 5
 )
 
+// Prefix of the names of the root data dirs created
+// in the temporary dir for networks not given one
+const rootDataDirPrefix = "network-runner-root-data"
+
 func New(cfg Config) (Server, error) {
 	if cfg.Port == "" || cfg.GwPort == "" {
 		return nil, ErrInvalidPort
 	}
-
-	ln, err := net.Listen("tcp", cfg.Port)
-	if err != nil {
-		return nil, err
+	if cfg.SnapshotsDir == "" {
+		cfg.SnapshotsDir = DefaultSnapshotsDir
 	}
-	gwMux := runtime.NewServeMux()
-	return &server{
+
+	s := &server{
 		cfg: cfg,
 
 		closed: make(chan struct{}),
 
-		ln:         ln,
-		gRPCServer: grpc.NewServer(),
+		gwMux: runtime.NewServeMux(),
 
-		gwMux: gwMux,
-		gwServer: &http.Server{
-			Addr:    cfg.GwPort,
-			Handler: gwMux,
-		},
+		networks:     map[string]*networkEntry{},
+		rootDataDirs: map[string]string{},
+	}
+	var err error
+	s.metrics, err = newMetrics(s.getNodeInfos)
+	if err != nil {
+		return nil, err
+	}
+	auth, err := grpcauth.NewAuthenticator(cfg.AuthTokens, cfg.ReadOnlyAuthTokens, readOnlyMethods)
+	if err != nil {
+		return nil, err
+	}
+	serverTLS, gwDialTLS, err := cfg.tlsConfigs()
+	if err != nil {
+		return nil, err
+	}
+	s.gwDialTLS = gwDialTLS
+
+	// failed authentications are counted in the metrics
+	unaryInterceptors := []grpc.UnaryServerInterceptor{s.metrics.unaryInterceptor}
+	streamInterceptors := []grpc.StreamServerInterceptor{s.metrics.streamInterceptor}
+	if auth != nil {
+		unaryInterceptors = append(unaryInterceptors, auth.UnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, auth.StreamInterceptor)
+	}
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+	if serverTLS != nil {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(serverTLS)))
+	}
+	s.gRPCServer = grpc.NewServer(serverOpts...)
+	metricsHandler := s.metrics.handler(s.getNodeInfos)
+	if auth != nil {
+		metricsHandler = auth.HTTPHandler(metricsHandler)
+	}
+	gwHandler := http.NewServeMux()
+	gwHandler.Handle(metricsPath, metricsHandler)
+	gwHandler.Handle("/", s.gwMux)
+	s.gwServer = &http.Server{
+		Addr:      cfg.GwPort,
+		Handler:   gwHandler,
+		TLSConfig: serverTLS,
+	}
+	if cfg.MetricsPort != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle(metricsPath, metricsHandler)
+		s.metricsServer = &http.Server{
+			Addr:      cfg.MetricsPort,
+			Handler:   metricsMux,
+			TLSConfig: serverTLS,
+		}
+	}
 
-		mu: new(sync.RWMutex),
-	}, nil
+	s.ln, err = net.Listen("tcp", cfg.Port)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
 }
 
 func (s *server) Run(rootCtx context.Context) (err error) {
@@ -123,6 +229,12 @@ func (s *server) Run(rootCtx context.Context) (err error) {
 		rpcpb.RegisterControlServiceServer(s.gRPCServer, s)
 	})
 
+	for _, rootDataDir := range s.cfg.ResumeRootDataDirs {
+		if _, err := s.Resume(rootCtx, &rpcpb.ResumeRequest{RootDataDir: rootDataDir}); err != nil {
+			return fmt.Errorf("couldn't resume network of %s: %w", rootDataDir, err)
+		}
+	}
+
 	gRPCErrc := make(chan error)
 	go func() {
 		zap.L().Info("serving gRPC server", zap.String("port", s.cfg.Port))
@@ -133,11 +245,15 @@ func (s *server) Run(rootCtx context.Context) (err error) {
 	go func() {
 		zap.L().Info("dialing gRPC server", zap.String("port", s.cfg.Port))
 		ctx, cancel := context.WithTimeout(rootCtx, s.cfg.DialTimeout)
+		creds := insecure.NewCredentials()
+		if s.gwDialTLS != nil {
+			creds = credentials.NewTLS(s.gwDialTLS)
+		}
 		gwConn, err := grpc.DialContext(
 			ctx,
 			"0.0.0.0"+s.cfg.Port,
 			grpc.WithBlock(),
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithTransportCredentials(creds),
 		)
 		cancel()
 		if err != nil {
@@ -156,9 +272,21 @@ func (s *server) Run(rootCtx context.Context) (err error) {
 		}
 
 		zap.L().Info("serving gRPC gateway", zap.String("port", s.cfg.GwPort))
-		gwErrc <- s.gwServer.ListenAndServe()
+		gwErrc <- s.listenAndServe(s.gwServer)
 	}()
 
+	if s.metricsServer != nil {
+		go func() {
+			zap.L().Info("serving metrics", zap.String("port", s.cfg.MetricsPort))
+			if err := s.listenAndServe(s.metricsServer); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				zap.L().Warn("metrics server failed", zap.Error(err))
+			}
+		}()
+		defer func() {
+			zap.L().Warn("closed metrics server", zap.Error(s.metricsServer.Close()))
+		}()
+	}
+
 	select {
 	case <-rootCtx.Done():
 		zap.L().Warn("root context is done")
@@ -188,6 +316,15 @@ func (s *server) Run(rootCtx context.Context) (err error) {
 	return err
 }
 
+// listenAndServe serves [httpServer], over TLS if enabled
+func (s *server) listenAndServe(httpServer *http.Server) error {
+	if httpServer.TLSConfig != nil {
+		// the cert is in the TLS config
+		return httpServer.ListenAndServeTLS("", "")
+	}
+	return httpServer.ListenAndServe()
+}
+
 func (s *server) Ping(ctx context.Context, req *rpcpb.PingRequest) (*rpcpb.PingResponse, error) {
 	zap.L().Debug("received ping request")
 	return &rpcpb.PingResponse{Pid: int32(os.Getpid())}, nil
@@ -206,6 +343,15 @@ func (s *server) Start(ctx context.Context, req *rpcpb.StartRequest) (*rpcpb.Sta
 		zap.L().Info("received start request with existing timeout", zap.String("deadline", deadline.String()))
 	}
 
+	var specConfig *network.Config
+	if req.GetSpec() != "" {
+		var err error
+		specConfig, err = applySpec(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if req.NumNodes == nil {
 		n := DefaultNodes
 		req.NumNodes = &n
@@ -255,11 +401,15 @@ func (s *server) Start(ctx context.Context, req *rpcpb.StartRequest) (*rpcpb.Sta
 		pluginDir = req.GetPluginDir()
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
 
-	// If [clusterInfo] is already populated, the server has already been started.
-	if s.clusterInfo != nil {
+	// If [clusterInfo] is already populated, the network has already been started.
+	if entry.clusterInfo != nil {
 		return nil, ErrAlreadyBootstrapped
 	}
 
@@ -272,20 +422,21 @@ func (s *server) Start(ctx context.Context, req *rpcpb.StartRequest) (*rpcpb.Sta
 		logLevel           = req.GetLogLevel()
 		globalNodeConfig   = req.GetGlobalNodeConfig()
 		customNodeConfigs  = req.GetCustomNodeConfigs()
-		err                error
 	)
 	if len(rootDataDir) == 0 {
-		rootDataDir, err = ioutil.TempDir(os.TempDir(), "network-runner-root-data")
+		rootDataDir, err = ioutil.TempDir(os.TempDir(), rootDataDirPrefix)
 		if err != nil {
 			return nil, err
 		}
 	}
-
-	s.clusterInfo = &rpcpb.ClusterInfo{
-		Pid:         pid,
-		RootDataDir: rootDataDir,
-		Healthy:     false,
+	if err := s.reserveRootDataDir(entry.name, rootDataDir); err != nil {
+		return nil, err
 	}
+	defer func() {
+		if entry.network == nil {
+			s.releaseRootDataDir(entry.name)
+		}
+	}()
 
 	zap.L().Info("starting",
 		zap.String("execPath", execPath),
@@ -295,41 +446,64 @@ func (s *server) Start(ctx context.Context, req *rpcpb.StartRequest) (*rpcpb.Sta
 		zap.String("rootDataDir", rootDataDir),
 		zap.String("pluginDir", pluginDir),
 		zap.String("defaultNodeConfig", globalNodeConfig),
+		zap.String("networkName", entry.name),
 	)
 
-	if s.network != nil {
-		return nil, ErrAlreadyBootstrapped
-	}
-
 	if len(customNodeConfigs) > 0 {
 		zap.L().Warn("custom node configs have been provided; ignoring the 'number-of-nodes' parameter and setting it to", zap.Int("numNodes", len(customNodeConfigs)))
 		numNodes = uint32(len(customNodeConfigs))
 	}
+	numBeacons := numNodes
+	if req.NumBeacons != nil {
+		numBeacons = req.GetNumBeacons()
+		if numBeacons == 0 || numBeacons > numNodes {
+			return nil, ErrInvalidNumBeacons
+		}
+	}
 
-	s.network, err = newLocalNetwork(localNetworkOptions{
+	lc, err := newLocalNetwork(localNetworkOptions{
 		execPath:           execPath,
 		rootDataDir:        rootDataDir,
 		numNodes:           numNodes,
+		numBeacons:         numBeacons,
 		whitelistedSubnets: whitelistedSubnets,
 		logLevel:           logLevel,
 		pluginDir:          pluginDir,
 		customVMs:          customVMs,
 		globalNodeConfig:   globalNodeConfig,
 		customNodeConfigs:  customNodeConfigs,
+		p2pProxy:           req.GetP2PProxy(),
+		distinctNodeIPs:    req.GetDistinctNodeIps(),
+		identitySeed:       req.GetIdentitySeed(),
+		genesisSpec:        req.GetGenesisSpec(),
+		cChainAllocations:  req.GetCChainAllocations(),
+		specConfig:         specConfig,
+		stopGracePeriod:    s.cfg.StopGracePeriod,
+		snapshotsDir:       s.cfg.SnapshotsDir,
+		events:             entry.events,
+		metrics:            s.metrics,
 
 		// to block racey restart
-		// "s.network.start" runs asynchronously
+		// "lc.start" runs asynchronously
 		// so it would not deadlock with the acquired lock
 		// in this "Start" method
-		restartMu: s.mu,
+		restartMu: entry.mu,
 	})
 	if err != nil {
 		return nil, err
 	}
+	entry.network = lc
+	entry.clusterInfo = &rpcpb.ClusterInfo{
+		Pid:         pid,
+		RootDataDir: rootDataDir,
+		Healthy:     false,
+		P2PProxy:    req.GetP2PProxy(),
+		NetworkName: entry.name,
+	}
 
 	// start non-blocking to install local cluster + custom VMs (if applicable)
 	// the user is expected to poll cluster status
-	go s.network.start(ctx)
+	go lc.start(ctx)
 
 	// update cluster info non-blocking
 	// the user is expected to poll this latest information
@@ -339,18 +513,20 @@ func (s *server) Start(ctx context.Context, req *rpcpb.StartRequest) (*rpcpb.Sta
 		select {
 		case <-s.closed:
 			return
-		case <-s.network.stopc:
+		case <-lc.stopc:
 			// TODO: fix race from shutdown
 			return
-		case serr := <-s.network.startErrc:
+		case serr := <-lc.startErrc:
 			zap.L().Warn("start failed to complete", zap.Error(serr))
-			panic(serr)
-		case <-s.network.localClusterReadyc:
-			s.mu.Lock()
-			s.clusterInfo.NodeNames = s.network.nodeNames
-			s.clusterInfo.NodeInfos = s.network.nodeInfos
-			s.clusterInfo.Healthy = true
-			s.mu.Unlock()
+			s.startFailed(entry, lc)
+			return
+		case <-lc.localClusterReadyc:
+			entry.mu.Lock()
+			entry.clusterInfo.NodeNames = lc.nodeNames
+			entry.clusterInfo.NodeInfos = lc.nodeInfos
+			entry.clusterInfo.Healthy = true
+			entry.saveState()
+			entry.mu.Unlock()
 		}
 
 		if len(req.GetCustomVms()) == 0 {
@@ -360,53 +536,174 @@ func (s *server) Start(ctx context.Context, req *rpcpb.StartRequest) (*rpcpb.Sta
 			select {
 			case <-s.closed:
 				return
-			case <-s.network.stopc:
+			case <-lc.stopc:
 				return
-			case serr := <-s.network.startErrc:
+			case serr := <-lc.startErrc:
 				zap.L().Warn("start custom VMs failed to complete", zap.Error(serr))
-				panic(serr)
-			case <-s.network.customVMsReadyc:
-				s.mu.Lock()
-				s.clusterInfo.CustomVmsHealthy = true
-				s.clusterInfo.CustomVms = make(map[string]*rpcpb.CustomVmInfo)
-				for vmID, vmInfo := range s.network.customVMIDToInfo {
-					s.clusterInfo.CustomVms[vmID.String()] = vmInfo.info
+				s.startFailed(entry, lc)
+				return
+			case <-lc.customVMsReadyc:
+				entry.mu.Lock()
+				entry.clusterInfo.CustomVmsHealthy = true
+				entry.clusterInfo.CustomVms = make(map[string]*rpcpb.CustomVmInfo)
+				for vmID, vmInfo := range lc.customVMIDToInfo {
+					entry.clusterInfo.CustomVms[vmID.String()] = vmInfo.info
 				}
-				s.mu.Unlock()
+				entry.saveState()
+				entry.mu.Unlock()
 			}
 		}
 	}()
 
-	return &rpcpb.StartResponse{ClusterInfo: s.clusterInfo}, nil
+	return &rpcpb.StartResponse{ClusterInfo: entry.cloneClusterInfo()}, nil
+}
+
+// startFailed stops network [lc] of [entry], which failed to start, so
+// that it can be started again. The failure was published as an event.
+// The other networks of the server keep running.
+func (s *server) startFailed(entry *networkEntry, lc *localNetwork) {
+	// returns once it reported the failure, so [lc.nw] isn't set meanwhile
+	<-lc.startDonec
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network != lc {
+		return
+	}
+	lc.stop(context.Background())
+	entry.network = nil
+	entry.clusterInfo = nil
+	s.releaseRootDataDir(entry.name)
+	// a network that failed to resume keeps its state, to be resumed again
+	if lc.options.state == nil {
+		removeState(lc.options.rootDataDir)
+	}
+}
+
+// applySpec replaces the fields of [req] given by its network spec,
+// and returns the config of the network of the spec
+func applySpec(req *rpcpb.StartRequest) (*network.Config, error) {
+	networkSpec, err := spec.Parse([]byte(req.GetSpec()))
+	if err != nil {
+		return nil, fmt.Errorf("invalid network spec: %w", err)
+	}
+	nw, err := networkSpec.Build()
+	if err != nil {
+		return nil, fmt.Errorf("invalid network spec: %w", err)
+	}
+	// nodes added later run the binary of the spec, or of its first node
+	req.ExecPath = networkSpec.Binary
+	if req.ExecPath == "" {
+		req.ExecPath = nw.Config.NodeConfigs[0].BinaryPath
+	}
+	numNodes := uint32(len(nw.Config.NodeConfigs))
+	req.NumNodes = &numNodes
+	req.PluginDir = &nw.PluginDir
+	req.CustomVms = nw.CustomVMs
+	req.WhitelistedSubnets = &nw.WhitelistedSubnets
+	req.LogLevel = &nw.Config.LogLevel
+	req.P2PProxy = &nw.Config.P2PProxy
+	req.DistinctNodeIps = &nw.Config.DistinctNodeIPs
+	req.IdentitySeed = &networkSpec.IdentitySeed
+	// these are given by the node configs of the spec
+	req.GlobalNodeConfig = nil
+	req.CustomNodeConfigs = nil
+	req.GenesisSpec = nil
+	return &nw.Config, nil
 }
 
 func (s *server) Health(ctx context.Context, req *rpcpb.HealthRequest) (*rpcpb.HealthResponse, error) {
-	zap.L().Debug("health")
-	if info := s.getClusterInfo(); info == nil {
+	zap.L().Debug("health", zap.Strings("node-names", req.NodeNames))
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	if info := entry.getClusterInfo(); info == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	if len(req.NodeNames) != 0 {
+		return entry.nodesHealth(ctx, req.NodeNames)
+	}
+
+	entry.mu.RLock()
+	lc := entry.network
+	entry.mu.RUnlock()
+	if lc == nil {
 		return nil, ErrNotBootstrapped
 	}
 
 	zap.L().Info("waiting for local cluster readiness")
-	if err := s.network.waitForLocalClusterReady(ctx); err != nil {
+	if err := lc.waitForLocalClusterReady(ctx); err != nil {
 		return nil, err
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
 
-	s.network.nodeNames = make([]string, 0)
-	for name := range s.network.nodeInfos {
-		s.network.nodeNames = append(s.network.nodeNames, name)
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, ErrNotBootstrapped
 	}
-	s.clusterInfo.NodeNames = s.network.nodeNames
-	s.clusterInfo.NodeInfos = s.network.nodeInfos
 
-	return &rpcpb.HealthResponse{ClusterInfo: s.clusterInfo}, nil
+	entry.network.nodeNames = make([]string, 0)
+	for name := range entry.network.nodeInfos {
+		entry.network.nodeNames = append(entry.network.nodeNames, name)
+	}
+	entry.clusterInfo.NodeNames = entry.network.nodeNames
+	entry.clusterInfo.NodeInfos = entry.network.nodeInfos
+	entry.network.updateNodeInfos(entry.clusterInfo)
+	entry.clusterInfo.Healthy = true
+
+	return &rpcpb.HealthResponse{ClusterInfo: entry.cloneClusterInfo()}, nil
+}
+
+// nodesHealth waits for the nodes [nodeNames] of a ready
+// network to be healthy
+func (entry *networkEntry) nodesHealth(ctx context.Context, nodeNames []string) (*rpcpb.HealthResponse, error) {
+	entry.mu.RLock()
+	lc := entry.network
+	if lc == nil {
+		entry.mu.RUnlock()
+		return nil, ErrNotBootstrapped
+	}
+	for _, nodeName := range nodeNames {
+		if _, ok := lc.nodeInfos[nodeName]; !ok {
+			entry.mu.RUnlock()
+			return nil, ErrNodeNotFound
+		}
+	}
+	entry.mu.RUnlock()
+
+	select {
+	case <-lc.localClusterReadyc:
+	default:
+		return nil, ErrNotBootstrapped
+	}
+
+	zap.L().Info("waiting for nodes readiness", zap.Strings("node-names", nodeNames))
+	select {
+	case <-lc.stopc:
+		return nil, errAborted
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case err := <-lc.nw.WaitForNodes(ctx, nodeNames...):
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &rpcpb.HealthResponse{ClusterInfo: entry.getClusterInfo()}, nil
 }
 
 func (s *server) URIs(ctx context.Context, req *rpcpb.URIsRequest) (*rpcpb.URIsResponse, error) {
 	zap.L().Debug("uris")
-	info := s.getClusterInfo()
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	info := entry.getClusterInfo()
 	if info == nil {
 		return nil, ErrNotBootstrapped
 	}
@@ -420,7 +717,11 @@ func (s *server) URIs(ctx context.Context, req *rpcpb.URIsRequest) (*rpcpb.URIsR
 
 func (s *server) Status(ctx context.Context, req *rpcpb.StatusRequest) (*rpcpb.StatusResponse, error) {
 	zap.L().Debug("received status request")
-	info := s.getClusterInfo()
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	info := entry.getClusterInfo()
 	if info == nil {
 		return nil, ErrNotBootstrapped
 	}
@@ -429,7 +730,11 @@ func (s *server) Status(ctx context.Context, req *rpcpb.StatusRequest) (*rpcpb.S
 
 func (s *server) StreamStatus(req *rpcpb.StreamStatusRequest, stream rpcpb.ControlService_StreamStatusServer) (err error) {
 	zap.L().Info("received bootstrap status request")
-	if s.getClusterInfo() == nil {
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return err
+	}
+	if entry.getClusterInfo() == nil {
 		return ErrNotBootstrapped
 	}
 
@@ -440,7 +745,7 @@ func (s *server) StreamStatus(req *rpcpb.StreamStatusRequest, stream rpcpb.Contr
 	wg := sync.WaitGroup{}
 	wg.Add(1)
 	go func() {
-		s.sendLoop(stream, interval)
+		s.sendLoop(stream, entry, interval)
 		wg.Done()
 	}()
 
@@ -473,7 +778,7 @@ func (s *server) StreamStatus(req *rpcpb.StreamStatusRequest, stream rpcpb.Contr
 	return err
 }
 
-func (s *server) sendLoop(stream rpcpb.ControlService_StreamStatusServer, interval time.Duration) {
+func (s *server) sendLoop(stream rpcpb.ControlService_StreamStatusServer, entry *networkEntry, interval time.Duration) {
 	zap.L().Info("start status send loop")
 
 	tc := time.NewTicker(1)
@@ -490,7 +795,7 @@ func (s *server) sendLoop(stream rpcpb.ControlService_StreamStatusServer, interv
 		}
 
 		zap.L().Debug("sending cluster info")
-		if err := stream.Send(&rpcpb.StreamStatusResponse{ClusterInfo: s.getClusterInfo()}); err != nil {
+		if err := stream.Send(&rpcpb.StreamStatusResponse{ClusterInfo: entry.getClusterInfo()}); err != nil {
 			if isClientCanceled(stream.Context().Err(), err) {
 				zap.L().Debug("client stream canceled", zap.Error(err))
 				return
@@ -528,26 +833,35 @@ func (s *server) recvLoop(stream rpcpb.ControlService_StreamStatusServer) error
 
 func (s *server) AddNode(ctx context.Context, req *rpcpb.AddNodeRequest) (*rpcpb.AddNodeResponse, error) {
 	zap.L().Debug("received add node request", zap.String("name", req.Name))
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
 
-	if info := s.getClusterInfo(); info == nil {
+	if info := entry.getClusterInfo(); info == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, ErrNotBootstrapped
+	}
 
 	var logLevel, whitelistedSubnets, pluginDir string
 
-	if _, exists := s.network.nodeInfos[req.Name]; exists {
+	if _, exists := entry.network.nodeInfos[req.Name]; exists {
 		return nil, fmt.Errorf("node with name %s already exists", req.Name)
 	}
 	// user can override bin path for this node...
 	execPath := req.StartRequest.ExecPath
 	if execPath == "" {
 		// ...or use the same binary as the rest of the network
-		execPath = s.network.binPath
+		execPath = entry.network.binPath
 	}
-	_, err := os.Stat(execPath)
+	_, err = os.Stat(execPath)
 	if err != nil {
 		if errors.Is(err, fs.ErrNotExist) {
 			return nil, utils.ErrNotExists
@@ -559,10 +873,10 @@ func (s *server) AddNode(ctx context.Context, req *rpcpb.AddNodeRequest) (*rpcpb
 	}
 
 	// use same configs from other nodes
-	whitelistedSubnets = s.network.options.whitelistedSubnets
-	pluginDir = s.network.options.pluginDir
+	whitelistedSubnets = entry.network.options.whitelistedSubnets
+	pluginDir = entry.network.options.pluginDir
 
-	rootDataDir := s.clusterInfo.RootDataDir
+	rootDataDir := entry.clusterInfo.RootDataDir
 
 	logDir := filepath.Join(rootDataDir, req.Name, "log")
 	dbDir := filepath.Join(rootDataDir, req.Name, "db-dir")
@@ -587,7 +901,7 @@ func (s *server) AddNode(ctx context.Context, req *rpcpb.AddNodeRequest) (*rpcpb
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate json node config string: %w", err)
 	}
-	stakingCert, stakingKey, err := staking.NewCertAndKeyBytes()
+	stakingCert, stakingKey, err := entry.network.newStakingIdentity(req.StartRequest.GetIdentitySeed())
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate staking Cert/Key: %w", err)
 	}
@@ -598,15 +912,17 @@ func (s *server) AddNode(ctx context.Context, req *rpcpb.AddNodeRequest) (*rpcpb
 		StakingKey:     string(stakingKey),
 		StakingCert:    string(stakingCert),
 		BinaryPath:     execPath,
+		BootstrapNodes: req.BootstrapNodes,
 		RedirectStdout: true,
 		RedirectStderr: true,
 	}
-	_, err = s.network.nw.AddNode(nodeConfig)
+	_, err = entry.network.nw.AddNode(nodeConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	s.network.nodeNames = append(s.network.nodeNames, req.Name)
+	entry.network.cfg.NodeConfigs = append(entry.network.cfg.NodeConfigs, nodeConfig)
+	entry.network.nodeNames = append(entry.network.nodeNames, req.Name)
 
 	info := &rpcpb.NodeInfo{
 		Name:               req.Name,
@@ -618,60 +934,147 @@ func (s *server) AddNode(ctx context.Context, req *rpcpb.AddNodeRequest) (*rpcpb
 		WhitelistedSubnets: whitelistedSubnets,
 		Config:             []byte(configFile),
 	}
-	s.network.nodeInfos[req.Name] = info
+	entry.network.nodeInfos[req.Name] = info
+	entry.saveState()
 
-	return &rpcpb.AddNodeResponse{ClusterInfo: s.clusterInfo}, nil
+	return &rpcpb.AddNodeResponse{ClusterInfo: entry.cloneClusterInfo()}, nil
+}
+
+func (s *server) WatchEvents(req *rpcpb.WatchEventsRequest, stream rpcpb.ControlService_WatchEventsServer) error {
+	zap.L().Info("received watch events request",
+		zap.Strings("event-types", req.EventTypes),
+		zap.Strings("node-names", req.NodeNames),
+	)
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return err
+	}
+
+	eventTypes := make(map[network.EventType]struct{}, len(req.EventTypes))
+	for _, eventType := range req.EventTypes {
+		if !isEventType(eventType) {
+			return fmt.Errorf("%w: %q", ErrInvalidEventType, eventType)
+		}
+		eventTypes[network.EventType(eventType)] = struct{}{}
+	}
+	nodeNames := make(map[string]struct{}, len(req.NodeNames))
+	for _, nodeName := range req.NodeNames {
+		nodeNames[nodeName] = struct{}{}
+	}
+
+	eventsCh, unsubscribe := entry.events.Subscribe()
+	defer unsubscribe()
+	for {
+		var event network.Event
+		select {
+		case <-s.rootCtx.Done():
+			return nil
+		case <-s.closed:
+			return nil
+		case <-stream.Context().Done():
+			err := stream.Context().Err()
+			if errors.Is(err, context.Canceled) {
+				err = ErrEventsCanceled
+			}
+			return err
+		case event = <-eventsCh:
+		}
+
+		if _, ok := eventTypes[event.Type]; len(eventTypes) != 0 && !ok {
+			continue
+		}
+		if _, ok := nodeNames[event.NodeName]; len(nodeNames) != 0 && !ok {
+			continue
+		}
+		if err := stream.Send(&rpcpb.WatchEventsResponse{Event: &rpcpb.Event{
+			Type:      string(event.Type),
+			Timestamp: event.Time.UnixNano(),
+			NodeName:  event.NodeName,
+			Message:   event.Message,
+		}}); err != nil {
+			if isClientCanceled(stream.Context().Err(), err) {
+				zap.L().Debug("client stream canceled", zap.Error(err))
+				return nil
+			}
+			zap.L().Warn("failed to send an event", zap.Error(err))
+			return err
+		}
+	}
+}
+
+func isEventType(eventType string) bool {
+	for _, knownType := range network.EventTypes {
+		if network.EventType(eventType) == knownType {
+			return true
+		}
+	}
+	return false
 }
 
 func (s *server) RemoveNode(ctx context.Context, req *rpcpb.RemoveNodeRequest) (*rpcpb.RemoveNodeResponse, error) {
 	zap.L().Debug("received remove node request", zap.String("name", req.Name))
-	if info := s.getClusterInfo(); info == nil {
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	if info := entry.getClusterInfo(); info == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, ErrNotBootstrapped
+	}
 
-	if _, ok := s.network.nodeInfos[req.Name]; !ok {
+	if _, ok := entry.network.nodeInfos[req.Name]; !ok {
 		return nil, ErrNodeNotFound
 	}
 
-	if err := s.network.nw.RemoveNode(req.Name); err != nil {
+	if err := entry.network.nw.RemoveNode(req.Name); err != nil {
 		return nil, err
 	}
-	delete(s.network.nodeInfos, req.Name)
-	s.network.nodeNames = make([]string, 0)
-	for name := range s.network.nodeInfos {
-		s.network.nodeNames = append(s.network.nodeNames, name)
-	}
-	s.clusterInfo.NodeNames = s.network.nodeNames
-	s.clusterInfo.NodeInfos = s.network.nodeInfos
+	entry.network.forgetNode(req.Name)
+	entry.clusterInfo.NodeNames = entry.network.nodeNames
+	entry.clusterInfo.NodeInfos = entry.network.nodeInfos
+	entry.saveState()
 
 	zap.L().Info("waiting for local cluster readiness")
-	if err := s.network.waitForLocalClusterReady(ctx); err != nil {
+	if err := entry.network.waitForLocalClusterReady(ctx); err != nil {
 		return nil, err
 	}
 
-	return &rpcpb.RemoveNodeResponse{ClusterInfo: s.clusterInfo}, nil
+	return &rpcpb.RemoveNodeResponse{ClusterInfo: entry.cloneClusterInfo()}, nil
 }
 
 func (s *server) RestartNode(ctx context.Context, req *rpcpb.RestartNodeRequest) (*rpcpb.RestartNodeResponse, error) {
 	zap.L().Debug("received remove node request", zap.String("name", req.Name))
-	if info := s.getClusterInfo(); info == nil {
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	if info := entry.getClusterInfo(); info == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, ErrNotBootstrapped
+	}
 
-	nodeInfo, ok := s.network.nodeInfos[req.Name]
+	nodeInfo, ok := entry.network.nodeInfos[req.Name]
 	if !ok {
 		return nil, ErrNodeNotFound
 	}
 
 	found, idx := false, 0
 	oldNodeConfig := node.Config{}
-	for i, cfg := range s.network.cfg.NodeConfigs {
+	for i, cfg := range entry.network.cfg.NodeConfigs {
 		if cfg.Name == req.Name {
 			oldNodeConfig = cfg
 			found = true
@@ -727,57 +1130,454 @@ func (s *server) RestartNode(ctx context.Context, req *rpcpb.RestartNodeRequest)
 
 	// now remove the node before restart
 	zap.L().Info("removing the node")
-	if err := s.network.nw.RemoveNode(req.Name); err != nil {
+	if err := entry.network.nw.RemoveNode(req.Name); err != nil {
 		return nil, err
 	}
 
 	// now adding the new node
 	zap.L().Info("adding the node")
-	if _, err := s.network.nw.AddNode(nodeConfig); err != nil {
+	if _, err := entry.network.nw.AddNode(nodeConfig); err != nil {
 		return nil, err
 	}
 
 	zap.L().Info("waiting for local cluster readiness")
-	if err := s.network.waitForLocalClusterReady(ctx); err != nil {
+	if err := entry.network.waitForLocalClusterReady(ctx); err != nil {
 		return nil, err
 	}
 
 	// update with the new config
-	s.network.cfg.NodeConfigs[idx] = nodeConfig
-	s.clusterInfo.NodeInfos = s.network.nodeInfos
+	entry.network.cfg.NodeConfigs[idx] = nodeConfig
+	entry.clusterInfo.NodeInfos = entry.network.nodeInfos
+	entry.saveState()
+
+	return &rpcpb.RestartNodeResponse{ClusterInfo: entry.cloneClusterInfo()}, nil
+}
+
+func (s *server) RollingUpgrade(ctx context.Context, req *rpcpb.RollingUpgradeRequest) (*rpcpb.RollingUpgradeResponse, error) {
+	zap.L().Debug("received rolling upgrade request",
+		zap.String("exec-path", req.ExecPath),
+		zap.Strings("node-names", req.NodeNames),
+		zap.Uint32("batch-size", req.BatchSize),
+		zap.Bool("rollback", req.Rollback),
+	)
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	if info := entry.getClusterInfo(); info == nil {
+		return nil, ErrNotBootstrapped
+	}
+	if err := utils.CheckExecPluginPaths(req.ExecPath, "", ""); err != nil {
+		return nil, err
+	}
+
+	entry.opMu.Lock()
+	defer entry.opMu.Unlock()
+
+	entry.mu.RLock()
+	lc := entry.network
+	entry.mu.RUnlock()
+	if lc == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	// The lock isn't held while the nodes are upgraded, which may take
+	// minutes, so that the network can be queried meanwhile
+	opts := network.UpgradeOptions{
+		NodeNames:     req.NodeNames,
+		BatchSize:     int(req.BatchSize),
+		HealthTimeout: time.Duration(req.HealthTimeoutMs) * time.Millisecond,
+		Rollback:      req.Rollback,
+	}
+	upgradeErr := lc.nw.RollingUpgrade(ctx, req.ExecPath, opts)
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network != lc {
+		return nil, ErrNotBootstrapped
+	}
+
+	// some nodes may run another binary even if the upgrade failed
+	nodes, err := entry.network.nw.GetAllNodes()
+	if err != nil {
+		return nil, err
+	}
+	for i, nodeConfig := range entry.network.cfg.NodeConfigs {
+		node, ok := nodes[nodeConfig.Name]
+		if !ok {
+			continue
+		}
+		entry.network.cfg.NodeConfigs[i] = node.GetConfig()
+		entry.network.apiClis[nodeConfig.Name] = node.GetAPIClient()
+		if nodeInfo, ok := entry.network.nodeInfos[nodeConfig.Name]; ok {
+			nodeInfo.ExecPath = node.GetConfig().BinaryPath
+			nodeInfo.Version = getNodeVersion(ctx, node)
+		}
+	}
+	entry.clusterInfo.NodeInfos = entry.network.nodeInfos
+	entry.saveState()
+	if upgradeErr != nil {
+		return nil, upgradeErr
+	}
+
+	return &rpcpb.RollingUpgradeResponse{ClusterInfo: entry.cloneClusterInfo()}, nil
+}
+
+func (s *server) Apply(ctx context.Context, req *rpcpb.ApplyRequest) (*rpcpb.ApplyResponse, error) {
+	zap.L().Debug("received apply request", zap.Bool("dry-run", req.DryRun))
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	if info := entry.getClusterInfo(); info == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	networkSpec, err := spec.Parse([]byte(req.Spec))
+	if err != nil {
+		return nil, fmt.Errorf("invalid network spec: %w", err)
+	}
+	nw, err := networkSpec.Build()
+	if err != nil {
+		return nil, fmt.Errorf("invalid network spec: %w", err)
+	}
+
+	entry.opMu.Lock()
+	defer entry.opMu.Unlock()
+
+	lc, steps, info, err := entry.apply(networkSpec, nw, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	if req.DryRun {
+		resp := &rpcpb.ApplyResponse{ClusterInfo: info}
+		for _, step := range steps {
+			step.step.Status = applyStatusPlanned
+			resp.Steps = append(resp.Steps, step.step)
+		}
+		return resp, nil
+	}
+
+	if len(steps) > 0 {
+		// The lock isn't held while the nodes get healthy, which may
+		// take minutes, so that the network can be queried meanwhile
+		zap.L().Info("waiting for local cluster readiness")
+		if err := lc.waitForHealth(ctx, lc.nw.Healthy(ctx)); err != nil {
+			return nil, err
+		}
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network != lc {
+		return nil, ErrNotBootstrapped
+	}
+	if len(steps) > 0 {
+		if err := lc.setReady(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &rpcpb.ApplyResponse{ClusterInfo: entry.cloneClusterInfo()}
+	for _, step := range steps {
+		resp.Steps = append(resp.Steps, step.step)
+	}
+	return resp, nil
+}
+
+// apply plans the steps changing the network to [networkSpec], built
+// into [nw], and executes them unless [dryRun]. Returns the network
+// they're applied to, and its cluster info.
+func (entry *networkEntry) apply(networkSpec *spec.Spec, nw *spec.Network, dryRun bool) (*localNetwork, []*applyStep, *rpcpb.ClusterInfo, error) {
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, nil, nil, ErrNotBootstrapped
+	}
+
+	steps, err := entry.network.planApply(networkSpec, nw, entry.clusterInfo.RootDataDir)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if dryRun {
+		return entry.network, steps, entry.cloneClusterInfo(), nil
+	}
+
+	entry.network.executeApply(steps)
+	entry.clusterInfo.NodeNames = entry.network.nodeNames
+	entry.clusterInfo.NodeInfos = entry.network.nodeInfos
+	entry.saveState()
+	return entry.network, steps, entry.cloneClusterInfo(), nil
+}
+
+func (s *server) PauseNode(ctx context.Context, req *rpcpb.PauseNodeRequest) (*rpcpb.PauseNodeResponse, error) {
+	zap.L().Debug("received pause node request", zap.String("name", req.Name))
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	if info := entry.getClusterInfo(); info == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	nodeInfo, ok := entry.network.nodeInfos[req.Name]
+	if !ok {
+		return nil, ErrNodeNotFound
+	}
+	if err := entry.network.nw.PauseNode(req.Name); err != nil {
+		return nil, err
+	}
+	nodeInfo.Paused = true
+	// the network can't be healthy with a paused node
+	entry.clusterInfo.Healthy = false
+	entry.saveState()
+
+	return &rpcpb.PauseNodeResponse{ClusterInfo: entry.cloneClusterInfo()}, nil
+}
+
+func (s *server) ResumeNode(ctx context.Context, req *rpcpb.ResumeNodeRequest) (*rpcpb.ResumeNodeResponse, error) {
+	zap.L().Debug("received resume node request", zap.String("name", req.Name))
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	if info := entry.getClusterInfo(); info == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	nodeInfo, ok := entry.network.nodeInfos[req.Name]
+	if !ok {
+		return nil, ErrNodeNotFound
+	}
+	if err := entry.network.nw.ResumeNode(req.Name); err != nil {
+		return nil, err
+	}
+	nodeInfo.Paused = false
+	entry.saveState()
+
+	return &rpcpb.ResumeNodeResponse{ClusterInfo: entry.cloneClusterInfo()}, nil
+}
+
+func (s *server) SetBeacon(ctx context.Context, req *rpcpb.SetBeaconRequest) (*rpcpb.SetBeaconResponse, error) {
+	zap.L().Debug("received set beacon request", zap.String("name", req.Name), zap.Bool("is-beacon", req.IsBeacon))
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	if info := entry.getClusterInfo(); info == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	nodeInfo, ok := entry.network.nodeInfos[req.Name]
+	if !ok {
+		return nil, ErrNodeNotFound
+	}
+	if err := entry.network.nw.SetBeacon(req.Name, req.IsBeacon); err != nil {
+		return nil, err
+	}
+	nodeInfo.IsBeacon = req.IsBeacon
+	// so the node keeps its beacon status when restarted
+	for i := range entry.network.cfg.NodeConfigs {
+		if entry.network.cfg.NodeConfigs[i].Name == req.Name {
+			entry.network.cfg.NodeConfigs[i].IsBeacon = req.IsBeacon
+		}
+	}
+	entry.saveState()
+
+	return &rpcpb.SetBeaconResponse{ClusterInfo: entry.cloneClusterInfo()}, nil
+}
+
+func (s *server) CreatePartition(ctx context.Context, req *rpcpb.CreatePartitionRequest) (*rpcpb.CreatePartitionResponse, error) {
+	zap.L().Debug("received create partition request", zap.Int("groups", len(req.Groups)))
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	if info := entry.getClusterInfo(); info == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	groups := make([][]string, len(req.Groups))
+	for i, group := range req.Groups {
+		groups[i] = group.NodeNames
+	}
+	if err := entry.network.nw.Partition(groups); err != nil {
+		return nil, err
+	}
+	entry.clusterInfo.PartitionGroups = req.Groups
+
+	return &rpcpb.CreatePartitionResponse{ClusterInfo: entry.cloneClusterInfo()}, nil
+}
+
+func (s *server) HealPartition(ctx context.Context, req *rpcpb.HealPartitionRequest) (*rpcpb.HealPartitionResponse, error) {
+	zap.L().Debug("received heal partition request")
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	if info := entry.getClusterInfo(); info == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, ErrNotBootstrapped
+	}
 
-	return &rpcpb.RestartNodeResponse{ClusterInfo: s.clusterInfo}, nil
+	if err := entry.network.nw.Heal(); err != nil {
+		return nil, err
+	}
+	entry.clusterInfo.PartitionGroups = nil
+
+	return &rpcpb.HealPartitionResponse{ClusterInfo: entry.cloneClusterInfo()}, nil
+}
+
+func (s *server) SetFaults(ctx context.Context, req *rpcpb.SetFaultsRequest) (*rpcpb.SetFaultsResponse, error) {
+	zap.L().Debug("received set faults request",
+		zap.String("node-name", req.NodeName),
+		zap.String("peer-name", req.PeerName),
+	)
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	if info := entry.getClusterInfo(); info == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	faults := network.LinkFaults{
+		Delay:           time.Duration(req.GetFaults().GetDelayMs()) * time.Millisecond,
+		Jitter:          time.Duration(req.GetFaults().GetJitterMs()) * time.Millisecond,
+		Bandwidth:       req.GetFaults().GetBandwidth(),
+		DropProbability: req.GetFaults().GetDropProbability(),
+	}
+	if req.PeerName == "" {
+		err = entry.network.nw.SetNodeFaults(req.NodeName, faults)
+	} else {
+		err = entry.network.nw.SetLinkFaults(req.NodeName, req.PeerName, faults)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Replace the faults previously injected in the same node or link
+	injected := make([]*rpcpb.InjectedFaults, 0, len(entry.clusterInfo.Faults)+1)
+	for _, f := range entry.clusterInfo.Faults {
+		sameNode := f.NodeName == req.NodeName && f.PeerName == req.PeerName
+		sameLink := req.PeerName != "" && f.NodeName == req.PeerName && f.PeerName == req.NodeName
+		if !sameNode && !sameLink {
+			injected = append(injected, f)
+		}
+	}
+	if !faults.IsZero() {
+		injected = append(injected, &rpcpb.InjectedFaults{
+			NodeName: req.NodeName,
+			PeerName: req.PeerName,
+			Faults:   req.Faults,
+		})
+	}
+	entry.clusterInfo.Faults = injected
+
+	return &rpcpb.SetFaultsResponse{ClusterInfo: entry.cloneClusterInfo()}, nil
 }
 
 func (s *server) Stop(ctx context.Context, req *rpcpb.StopRequest) (*rpcpb.StopResponse, error) {
 	zap.L().Debug("received stop request")
-	info := s.getClusterInfo()
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	info := entry.getClusterInfo()
 	if info == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
 
-	s.network.stop(ctx)
-	s.network = nil
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+	entry.network.stop(ctx)
+	entry.network = nil
 	info.Healthy = false
-	s.clusterInfo = nil
+	entry.clusterInfo = nil
+	s.releaseRootDataDir(entry.name)
+	removeState(info.RootDataDir)
 
 	return &rpcpb.StopResponse{ClusterInfo: info}, nil
 }
 
 func (s *server) AttachPeer(ctx context.Context, req *rpcpb.AttachPeerRequest) (*rpcpb.AttachPeerResponse, error) {
 	zap.L().Debug("received attach peer request")
-	info := s.getClusterInfo()
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	info := entry.getClusterInfo()
 	if info == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, ErrNotBootstrapped
+	}
 
-	node, err := s.network.nw.GetNode((req.NodeName))
+	node, err := entry.network.nw.GetNode((req.NodeName))
 	if err != nil {
 		return nil, err
 	}
@@ -795,29 +1595,30 @@ func (s *server) AttachPeer(ctx context.Context, req *rpcpb.AttachPeerRequest) (
 		return nil, err
 	}
 	newPeerID := newPeer.ID().String()
+	entry.events.Publish(network.NewEvent(network.EventPeerAttached, req.NodeName, fmt.Sprintf("peer %s", newPeerID)))
 
 	zap.L().Debug("new peer is attached",
 		zap.String("node-name", req.NodeName),
 		zap.String("peer-id", newPeerID),
 	)
 
-	peers, ok := s.network.attachedPeers[req.NodeName]
+	peers, ok := entry.network.attachedPeers[req.NodeName]
 	if !ok {
 		peers = make(map[string]peer.Peer)
 		peers[newPeerID] = newPeer
 	} else {
 		peers[newPeerID] = newPeer
 	}
-	s.network.attachedPeers[req.NodeName] = peers
+	entry.network.attachedPeers[req.NodeName] = peers
 
-	if s.clusterInfo.AttachedPeerInfos == nil {
-		s.clusterInfo.AttachedPeerInfos = make(map[string]*rpcpb.ListOfAttachedPeerInfo)
+	if entry.clusterInfo.AttachedPeerInfos == nil {
+		entry.clusterInfo.AttachedPeerInfos = make(map[string]*rpcpb.ListOfAttachedPeerInfo)
 	}
 	peerInfo := &rpcpb.AttachedPeerInfo{Id: newPeerID}
-	if v, ok := s.clusterInfo.AttachedPeerInfos[req.NodeName]; ok {
+	if v, ok := entry.clusterInfo.AttachedPeerInfos[req.NodeName]; ok {
 		v.Peers = append(v.Peers, peerInfo)
 	} else {
-		s.clusterInfo.AttachedPeerInfos[req.NodeName] = &rpcpb.ListOfAttachedPeerInfo{
+		entry.clusterInfo.AttachedPeerInfos[req.NodeName] = &rpcpb.ListOfAttachedPeerInfo{
 			Peers: []*rpcpb.AttachedPeerInfo{peerInfo},
 		}
 	}
@@ -840,16 +1641,28 @@ func (lh *loggingInboundHandler) HandleInbound(m message.InboundMessage) {
 
 func (s *server) SendOutboundMessage(ctx context.Context, req *rpcpb.SendOutboundMessageRequest) (*rpcpb.SendOutboundMessageResponse, error) {
 	zap.L().Debug("received send outbound message request")
-	info := s.getClusterInfo()
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	info := entry.getClusterInfo()
 	if info == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	peers, ok := s.network.attachedPeers[req.NodeName]
+	entry.mu.RLock()
+	// stopped meanwhile
+	if entry.network == nil {
+		entry.mu.RUnlock()
+		return nil, ErrNotBootstrapped
+	}
+	peers, ok := entry.network.attachedPeers[req.NodeName]
 	if !ok {
+		entry.mu.RUnlock()
 		return nil, ErrNodeNotFound
 	}
 	attachedPeer, ok := peers[req.PeerId]
+	entry.mu.RUnlock()
 	if !ok {
 		return nil, ErrPeerNotFound
 	}
@@ -859,11 +1672,284 @@ func (s *server) SendOutboundMessage(ctx context.Context, req *rpcpb.SendOutboun
 	return &rpcpb.SendOutboundMessageResponse{Sent: sent}, nil
 }
 
-func (s *server) getClusterInfo() *rpcpb.ClusterInfo {
-	s.mu.RLock()
-	info := s.clusterInfo
-	s.mu.RUnlock()
-	return info
+func (s *server) SaveSnapshot(ctx context.Context, req *rpcpb.SaveSnapshotRequest) (*rpcpb.SaveSnapshotResponse, error) {
+	zap.L().Info("received save snapshot request", zap.String("snapshot-name", req.SnapshotName))
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	if info := entry.getClusterInfo(); info == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// stopped meanwhile
+	if entry.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	select {
+	case <-entry.network.localClusterReadyc:
+	default:
+		return nil, ErrNotBootstrapped
+	}
+
+	snapshotPath, err := entry.network.nw.SaveSnapshot(ctx, req.SnapshotName)
+	if err != nil {
+		zap.L().Warn("snapshot save failed to complete", zap.Error(err))
+		return nil, err
+	}
+	// so that the custom VMs aren't installed again once loaded
+	if err := entry.network.saveSnapshotState(snapshotPath); err != nil {
+		zap.L().Warn("snapshot save failed to complete", zap.Error(err))
+		return nil, err
+	}
+
+	// saving a snapshot stops the network
+	rootDataDir := entry.clusterInfo.RootDataDir
+	entry.network.stop(ctx)
+	entry.network = nil
+	entry.clusterInfo = nil
+	s.releaseRootDataDir(entry.name)
+	removeState(rootDataDir)
+
+	return &rpcpb.SaveSnapshotResponse{SnapshotPath: snapshotPath}, nil
+}
+
+func (s *server) LoadSnapshot(ctx context.Context, req *rpcpb.LoadSnapshotRequest) (*rpcpb.LoadSnapshotResponse, error) {
+	zap.L().Info("received load snapshot request", zap.String("snapshot-name", req.SnapshotName))
+
+	// "start" from the snapshot is async, so the request timeout doesn't apply
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultStartTimeout)
+	_ = cancel
+
+	if req.GetExecPath() != "" {
+		if err := utils.CheckExecPluginPaths(req.GetExecPath(), "", ""); err != nil {
+			return nil, err
+		}
+	}
+	snapshotNames, err := local.GetSnapshotNames(s.cfg.SnapshotsDir)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, snapshotName := range snapshotNames {
+		if snapshotName == req.SnapshotName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, ErrSnapshotNotFound
+	}
+
+	entry, err := s.getNetwork(req.NetworkName)
+	if err != nil {
+		return nil, err
+	}
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// If [clusterInfo] is already populated, the network has already been started.
+	if entry.clusterInfo != nil || entry.network != nil {
+		return nil, ErrAlreadyBootstrapped
+	}
+
+	rootDataDir := req.GetRootDataDir()
+	if len(rootDataDir) == 0 {
+		rootDataDir, err = ioutil.TempDir(os.TempDir(), rootDataDirPrefix)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := s.reserveRootDataDir(entry.name, rootDataDir); err != nil {
+		return nil, err
+	}
+
+	nw, err := newLocalNetwork(localNetworkOptions{
+		execPath:     req.GetExecPath(),
+		rootDataDir:  rootDataDir,
+		snapshotsDir: s.cfg.SnapshotsDir,
+		snapshotName: req.SnapshotName,
+		events:       entry.events,
+		restartMu:    entry.mu,
+		metrics:      s.metrics,
+	})
+	if err != nil {
+		s.releaseRootDataDir(entry.name)
+		return nil, err
+	}
+	if err := nw.loadSnapshotState(filepath.Join(s.cfg.SnapshotsDir, req.SnapshotName)); err != nil {
+		s.releaseRootDataDir(entry.name)
+		return nil, err
+	}
+	entry.network = nw
+	entry.clusterInfo = &rpcpb.ClusterInfo{
+		Pid:         int32(os.Getpid()),
+		RootDataDir: rootDataDir,
+		Healthy:     false,
+		NetworkName: entry.name,
+	}
+
+	// start non-blocking to load the snapshot
+	// the user is expected to poll cluster status
+	go nw.start(ctx)
+
+	// update cluster info non-blocking
+	go func() {
+		zap.L().Info("waiting for local cluster readiness")
+		select {
+		case <-s.closed:
+			return
+		case <-nw.stopc:
+			return
+		case serr := <-nw.startErrc:
+			zap.L().Warn("load snapshot failed to complete", zap.Error(serr))
+			s.startFailed(entry, nw)
+			return
+		case <-nw.localClusterReadyc:
+			entry.mu.Lock()
+			entry.clusterInfo.NodeNames = nw.nodeNames
+			entry.clusterInfo.NodeInfos = nw.nodeInfos
+			entry.clusterInfo.Healthy = true
+			// the custom VMs were installed before the snapshot was saved
+			if len(nw.customVMIDToInfo) > 0 {
+				entry.clusterInfo.CustomVmsHealthy = true
+				entry.clusterInfo.CustomVms = make(map[string]*rpcpb.CustomVmInfo)
+				for vmID, vmInfo := range nw.customVMIDToInfo {
+					entry.clusterInfo.CustomVms[vmID.String()] = vmInfo.info
+				}
+			}
+			entry.saveState()
+			entry.mu.Unlock()
+		}
+	}()
+
+	return &rpcpb.LoadSnapshotResponse{ClusterInfo: entry.cloneClusterInfo()}, nil
+}
+
+// Resume resumes the network whose state was saved to the root data dir
+// of the request by a server that exited without stopping it. Its nodes
+// that are still running are adopted, and the other ones are started
+// again from their databases. See local.ResumeNetwork.
+func (s *server) Resume(ctx context.Context, req *rpcpb.ResumeRequest) (*rpcpb.ResumeResponse, error) {
+	zap.L().Info("received resume request", zap.String("root-data-dir", req.RootDataDir))
+
+	// "start" from the state is async, so the request timeout doesn't apply
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultStartTimeout)
+	_ = cancel
+
+	state, err := readState(req.RootDataDir)
+	if err != nil {
+		return nil, err
+	}
+	networkName := req.NetworkName
+	if networkName == "" {
+		networkName = state.clusterInfo.NetworkName
+	}
+	entry, err := s.getNetwork(networkName)
+	if err != nil {
+		return nil, err
+	}
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	// If [clusterInfo] is already populated, the network has already been started.
+	if entry.clusterInfo != nil || entry.network != nil {
+		return nil, ErrAlreadyBootstrapped
+	}
+	if err := s.reserveRootDataDir(entry.name, req.RootDataDir); err != nil {
+		return nil, err
+	}
+
+	nw, err := newLocalNetwork(localNetworkOptions{
+		execPath:           state.ExecPath,
+		rootDataDir:        req.RootDataDir,
+		whitelistedSubnets: state.WhitelistedSubnets,
+		pluginDir:          state.PluginDir,
+		identitySeed:       state.IdentitySeed,
+		p2pProxy:           state.Config.P2PProxy,
+		distinctNodeIPs:    state.Config.DistinctNodeIPs,
+		snapshotsDir:       s.cfg.SnapshotsDir,
+		state:              state,
+		events:             entry.events,
+		restartMu:          entry.mu,
+		metrics:            s.metrics,
+	})
+	if err != nil {
+		s.releaseRootDataDir(entry.name)
+		return nil, err
+	}
+	entry.network = nw
+	entry.clusterInfo = &rpcpb.ClusterInfo{
+		Pid:         int32(os.Getpid()),
+		RootDataDir: req.RootDataDir,
+		Healthy:     false,
+		P2PProxy:    state.Config.P2PProxy,
+		NetworkName: entry.name,
+	}
+
+	// start non-blocking to resume the network
+	// the user is expected to poll cluster status
+	go nw.start(ctx)
+
+	// update cluster info non-blocking
+	go func() {
+		zap.L().Info("waiting for local cluster readiness")
+		select {
+		case <-s.closed:
+			return
+		case <-nw.stopc:
+			return
+		case serr := <-nw.startErrc:
+			zap.L().Warn("resume failed to complete", zap.Error(serr))
+			s.startFailed(entry, nw)
+			return
+		case <-nw.localClusterReadyc:
+			entry.mu.Lock()
+			entry.clusterInfo.NodeNames = nw.nodeNames
+			entry.clusterInfo.NodeInfos = nw.nodeInfos
+			// the network can't be healthy with a paused node
+			entry.clusterInfo.Healthy = true
+			for _, nodeInfo := range nw.nodeInfos {
+				if nodeInfo.Paused {
+					entry.clusterInfo.Healthy = false
+				}
+			}
+			// the custom VMs were installed by the previous server
+			if len(nw.customVMIDToInfo) > 0 {
+				entry.clusterInfo.CustomVmsHealthy = true
+				entry.clusterInfo.CustomVms = make(map[string]*rpcpb.CustomVmInfo)
+				for vmID, vmInfo := range nw.customVMIDToInfo {
+					entry.clusterInfo.CustomVms[vmID.String()] = vmInfo.info
+				}
+			}
+			entry.saveState()
+			entry.mu.Unlock()
+		}
+	}()
+
+	return &rpcpb.ResumeResponse{ClusterInfo: entry.cloneClusterInfo()}, nil
+}
+
+func (s *server) RemoveSnapshot(ctx context.Context, req *rpcpb.RemoveSnapshotRequest) (*rpcpb.RemoveSnapshotResponse, error) {
+	zap.L().Info("received remove snapshot request", zap.String("snapshot-name", req.SnapshotName))
+	if err := local.RemoveSnapshot(s.cfg.SnapshotsDir, req.SnapshotName); err != nil {
+		zap.L().Warn("snapshot remove failed to complete", zap.Error(err))
+		return nil, err
+	}
+	return &rpcpb.RemoveSnapshotResponse{}, nil
+}
+
+func (s *server) ListSnapshots(ctx context.Context, req *rpcpb.ListSnapshotsRequest) (*rpcpb.ListSnapshotsResponse, error) {
+	zap.L().Info("received list snapshots request")
+	snapshotNames, err := local.GetSnapshotNames(s.cfg.SnapshotsDir)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.ListSnapshotsResponse{SnapshotNames: snapshotNames}, nil
 }
 
 func isClientCanceled(ctxErr error, err error) bool {
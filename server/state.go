@@ -0,0 +1,214 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// File of the root data dir of a network where the server saves
+// the state of the network, to resume it once restarted
+const stateFileName = "network-runner-state.json"
+
+// networkState is what a server needs to resume a network
+// started by another server. See Resume.
+type networkState struct {
+	// protojson of the cluster info
+	ClusterInfo json.RawMessage `json:"clusterInfo"`
+	// Config of the network, with the current node configs
+	Config network.Config `json:"config"`
+
+	ExecPath           string `json:"execPath"`
+	PluginDir          string `json:"pluginDir"`
+	WhitelistedSubnets string `json:"whitelistedSubnets"`
+	IdentitySeed       string `json:"identitySeed"`
+	NextIdentityIndex  uint32 `json:"nextIdentityIndex"`
+
+	// [ClusterInfo], unmarshalled by readState
+	clusterInfo *rpcpb.ClusterInfo
+}
+
+// saveState saves the state of the network, of cluster info [clusterInfo],
+// to its root data dir. The file is replaced atomically, so that a server
+// exiting meanwhile leaves the previous state.
+func (lc *localNetwork) saveState(clusterInfo *rpcpb.ClusterInfo) error {
+	clusterInfoJSON, err := protojson.Marshal(clusterInfo)
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(networkState{
+		ClusterInfo:        clusterInfoJSON,
+		Config:             lc.cfg,
+		ExecPath:           lc.binPath,
+		PluginDir:          lc.options.pluginDir,
+		WhitelistedSubnets: lc.options.whitelistedSubnets,
+		IdentitySeed:       lc.options.identitySeed,
+		NextIdentityIndex:  lc.nextIdentityIndex,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(lc.options.rootDataDir, stateFileName)
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, b, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// restoreState sets the node configs, names and infos, and the
+// custom VMs of the network, to the ones of [state]
+func (lc *localNetwork) restoreState(state *networkState) error {
+	lc.cfg = state.Config
+	lc.nextIdentityIndex = state.NextIdentityIndex
+	lc.nodeNames = state.clusterInfo.NodeNames
+	for name, nodeInfo := range state.clusterInfo.NodeInfos {
+		// set again once the network is ready, along with [Paused],
+		// as only the adopted nodes may still be paused
+		nodeInfo.Uri = ""
+		nodeInfo.Id = ""
+		lc.nodeInfos[name] = nodeInfo
+	}
+	return lc.setCustomVMs(state.clusterInfo.CustomVms)
+}
+
+// setCustomVMs sets the custom VMs of the network, installed
+// before, to [customVMs], the VM infos by VM ID
+func (lc *localNetwork) setCustomVMs(customVMs map[string]*rpcpb.CustomVmInfo) error {
+	for vmIDStr, info := range customVMs {
+		vmID, err := ids.FromString(vmIDStr)
+		if err != nil {
+			return fmt.Errorf("invalid custom VM ID %q: %w", vmIDStr, err)
+		}
+		subnetID, err := ids.FromString(info.SubnetId)
+		if err != nil {
+			return fmt.Errorf("invalid subnet ID %q: %w", info.SubnetId, err)
+		}
+		blockchainID, err := ids.FromString(info.BlockchainId)
+		if err != nil {
+			return fmt.Errorf("invalid blockchain ID %q: %w", info.BlockchainId, err)
+		}
+		lc.customVMIDToInfo[vmID] = vmInfo{
+			info:         info,
+			subnetID:     subnetID,
+			blockchainID: blockchainID,
+		}
+	}
+	return nil
+}
+
+// readState reads the state of the network saved to [rootDataDir]
+func readState(rootDataDir string) (*networkState, error) {
+	b, err := os.ReadFile(filepath.Join(rootDataDir, stateFileName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrStateNotFound, rootDataDir)
+		}
+		return nil, err
+	}
+	state := &networkState{}
+	if err := json.Unmarshal(b, state); err != nil {
+		return nil, fmt.Errorf("couldn't unmarshal state of %s: %w", rootDataDir, err)
+	}
+	state.clusterInfo = &rpcpb.ClusterInfo{}
+	if err := protojson.Unmarshal(state.ClusterInfo, state.clusterInfo); err != nil {
+		return nil, fmt.Errorf("couldn't unmarshal cluster info of %s: %w", rootDataDir, err)
+	}
+	return state, nil
+}
+
+// removeState removes the state of the network saved
+// to [rootDataDir], once the network is stopped
+func removeState(rootDataDir string) {
+	err := os.Remove(filepath.Join(rootDataDir, stateFileName))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		zap.L().Warn("couldn't remove network state", zap.String("root-data-dir", rootDataDir), zap.Error(err))
+	}
+}
+
+// File of a snapshot dir where the server saves what the
+// snapshot of the network lacks to load its custom VMs
+const snapshotStateFileName = "network-runner-snapshot.json"
+
+// snapshotState is what a server needs, besides the snapshot of
+// the network, to load its custom VMs. See LoadSnapshot.
+type snapshotState struct {
+	// protojson of the custom VM infos by VM ID
+	CustomVMs          map[string]json.RawMessage `json:"customVms"`
+	PluginDir          string                     `json:"pluginDir"`
+	WhitelistedSubnets string                     `json:"whitelistedSubnets"`
+}
+
+// saveSnapshotState saves the custom VMs of the network
+// to [snapshotDir], the dir of its snapshot
+func (lc *localNetwork) saveSnapshotState(snapshotDir string) error {
+	state := snapshotState{
+		CustomVMs:          make(map[string]json.RawMessage, len(lc.customVMIDToInfo)),
+		PluginDir:          lc.options.pluginDir,
+		WhitelistedSubnets: lc.options.whitelistedSubnets,
+	}
+	for vmID, vmInfo := range lc.customVMIDToInfo {
+		infoJSON, err := protojson.Marshal(vmInfo.info)
+		if err != nil {
+			return err
+		}
+		state.CustomVMs[vmID.String()] = infoJSON
+	}
+	b, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(snapshotDir, snapshotStateFileName), b, 0o600)
+}
+
+// loadSnapshotState sets the custom VMs of the network to the
+// ones saved to [snapshotDir], the dir of the snapshot it's loaded
+// from. A snapshot saved without custom VMs has none.
+func (lc *localNetwork) loadSnapshotState(snapshotDir string) error {
+	b, err := os.ReadFile(filepath.Join(snapshotDir, snapshotStateFileName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	state := snapshotState{}
+	if err := json.Unmarshal(b, &state); err != nil {
+		return fmt.Errorf("couldn't unmarshal snapshot state of %s: %w", snapshotDir, err)
+	}
+	customVMs := make(map[string]*rpcpb.CustomVmInfo, len(state.CustomVMs))
+	for vmID, infoJSON := range state.CustomVMs {
+		info := &rpcpb.CustomVmInfo{}
+		if err := protojson.Unmarshal(infoJSON, info); err != nil {
+			return fmt.Errorf("couldn't unmarshal custom VM %q of %s: %w", vmID, snapshotDir, err)
+		}
+		customVMs[vmID] = info
+	}
+	lc.options.pluginDir = state.PluginDir
+	lc.options.whitelistedSubnets = state.WhitelistedSubnets
+	return lc.setCustomVMs(customVMs)
+}
+
+// saveState saves the state of the network, if started.
+// A failure is only logged, as the network runs regardless.
+// Assumes [entry.mu] is held.
+func (entry *networkEntry) saveState() {
+	if entry.network == nil || entry.clusterInfo == nil {
+		return
+	}
+	if err := entry.network.saveState(entry.clusterInfo); err != nil {
+		zap.L().Warn("couldn't save network state", zap.String("network-name", entry.name), zap.Error(err))
+	}
+}
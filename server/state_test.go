@@ -0,0 +1,139 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkState(t *testing.T) {
+	assert := assert.New(t)
+	rootDataDir := t.TempDir()
+
+	_, err := readState(rootDataDir)
+	assert.ErrorIs(err, ErrStateNotFound)
+
+	lc, err := newLocalNetwork(localNetworkOptions{
+		execPath:     "avalanchego",
+		rootDataDir:  rootDataDir,
+		numNodes:     2,
+		pluginDir:    "/plugins",
+		identitySeed: "seed",
+	})
+	assert.NoError(err)
+	vmID, subnetID, blockchainID := ids.GenerateTestID(), ids.GenerateTestID(), ids.GenerateTestID()
+	lc.nodeInfos["node1"].Uri = "http://127.0.0.1:9650"
+	lc.nodeInfos["node2"].Paused = true
+	assert.NoError(lc.saveState(&rpcpb.ClusterInfo{
+		NodeNames:   lc.nodeNames,
+		NodeInfos:   lc.nodeInfos,
+		RootDataDir: rootDataDir,
+		Healthy:     true,
+		NetworkName: "ci",
+		CustomVms: map[string]*rpcpb.CustomVmInfo{
+			vmID.String(): {
+				VmName:       "vm",
+				VmId:         vmID.String(),
+				SubnetId:     subnetID.String(),
+				BlockchainId: blockchainID.String(),
+			},
+		},
+	}))
+
+	state, err := readState(rootDataDir)
+	assert.NoError(err)
+	assert.Equal("ci", state.clusterInfo.NetworkName)
+	assert.Equal("avalanchego", state.ExecPath)
+	assert.Equal("seed", state.IdentitySeed)
+	resumed, err := newLocalNetwork(localNetworkOptions{
+		execPath:    state.ExecPath,
+		rootDataDir: rootDataDir,
+		state:       state,
+	})
+	assert.NoError(err)
+	assert.Equal(lc.cfg.NodeConfigs, resumed.cfg.NodeConfigs)
+	assert.Equal(lc.nodeNames, resumed.nodeNames)
+	assert.Equal(lc.nextIdentityIndex, resumed.nextIdentityIndex)
+	assert.Equal(lc.nodeInfos["node1"].DbDir, resumed.nodeInfos["node1"].DbDir)
+	// set again once resumed
+	assert.Empty(resumed.nodeInfos["node1"].Uri)
+	assert.True(resumed.nodeInfos["node2"].Paused)
+	assert.Equal(subnetID, resumed.customVMIDToInfo[vmID].subnetID)
+	assert.Equal(blockchainID, resumed.customVMIDToInfo[vmID].blockchainID)
+
+	removeState(rootDataDir)
+	_, err = readState(rootDataDir)
+	assert.ErrorIs(err, ErrStateNotFound)
+}
+
+func TestSnapshotState(t *testing.T) {
+	assert := assert.New(t)
+	snapshotDir := t.TempDir()
+
+	lc, err := newLocalNetwork(localNetworkOptions{
+		execPath:           "avalanchego",
+		rootDataDir:        t.TempDir(),
+		numNodes:           1,
+		pluginDir:          "/plugins",
+		whitelistedSubnets: "subnet",
+	})
+	assert.NoError(err)
+	loaded, err := newLocalNetwork(localNetworkOptions{
+		execPath:    "avalanchego",
+		rootDataDir: t.TempDir(),
+	})
+	assert.NoError(err)
+	// saved without custom VMs
+	assert.NoError(loaded.loadSnapshotState(snapshotDir))
+	assert.Empty(loaded.customVMIDToInfo)
+
+	vmID, subnetID, blockchainID := ids.GenerateTestID(), ids.GenerateTestID(), ids.GenerateTestID()
+	lc.customVMIDToInfo[vmID] = vmInfo{
+		info: &rpcpb.CustomVmInfo{
+			VmName:       "vm",
+			VmId:         vmID.String(),
+			SubnetId:     subnetID.String(),
+			BlockchainId: blockchainID.String(),
+		},
+		subnetID:     subnetID,
+		blockchainID: blockchainID,
+	}
+	assert.NoError(lc.saveSnapshotState(snapshotDir))
+	assert.NoError(loaded.loadSnapshotState(snapshotDir))
+	assert.Equal("/plugins", loaded.options.pluginDir)
+	assert.Equal("subnet", loaded.options.whitelistedSubnets)
+	assert.Equal(subnetID, loaded.customVMIDToInfo[vmID].subnetID)
+	assert.Equal(blockchainID, loaded.customVMIDToInfo[vmID].blockchainID)
+	assert.Equal("vm", loaded.customVMIDToInfo[vmID].info.VmName)
+}
+
+// TestResumeFailure checks that a network that fails to be resumed
+// is stopped, without stopping the server
+func TestResumeFailure(t *testing.T) {
+	assert := assert.New(t)
+	s := &server{
+		closed:       make(chan struct{}),
+		networks:     map[string]*networkEntry{},
+		rootDataDirs: map[string]string{},
+	}
+	rootDataDir := t.TempDir()
+	// the config of the network fails validation
+	lc := &localNetwork{options: localNetworkOptions{rootDataDir: rootDataDir}}
+	assert.NoError(lc.saveState(&rpcpb.ClusterInfo{NetworkName: "ci"}))
+
+	_, err := s.Resume(context.Background(), &rpcpb.ResumeRequest{RootDataDir: rootDataDir})
+	assert.NoError(err)
+	entry, err := s.getNetwork("ci")
+	assert.NoError(err)
+	assert.Eventually(func() bool {
+		return entry.getClusterInfo() == nil
+	}, 10*time.Second, 50*time.Millisecond)
+	assert.NoError(s.reserveRootDataDir(DefaultNetworkName, rootDataDir))
+	// kept to be resumed again
+	_, err = readState(rootDataDir)
+	assert.NoError(err)
+}
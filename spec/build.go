@@ -0,0 +1,188 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+// Network is a network built from a spec
+type Network struct {
+	// Config to create the network from
+	Config network.Config
+	// Dir of the binaries of the custom VMs. Empty if there are none.
+	PluginDir string
+	// Path of the genesis of each custom VM, by VM name
+	CustomVMs map[string]string
+	// Comma separated IDs of the subnets the nodes validate,
+	// besides the ones of the custom VMs
+	WhitelistedSubnets string
+}
+
+// Build returns the network of this spec. Files given by the spec
+// are read, and errors name the field at fault.
+func (s *Spec) Build() (*Network, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	seed := s.IdentitySeed
+	if seed == "" {
+		seed = local.DefaultIdentitySeed
+	}
+	// The nodes get the default staking identities and config files
+	cfg, err := local.NewDefaultConfigNNodesFromSeed(s.Binary, uint32(len(s.Nodes)), seed)
+	if err != nil {
+		return nil, err
+	}
+	if s.Name != "" {
+		cfg.Name = s.Name
+	}
+	if s.LogLevel != "" {
+		cfg.LogLevel = strings.ToUpper(s.LogLevel)
+	}
+	cfg.Flags = s.Flags
+	cfg.P2PProxy = s.P2PProxy
+	cfg.DistinctNodeIPs = s.DistinctNodeIPs
+
+	someNodeIsBeacon := false
+	for _, n := range s.Nodes {
+		someNodeIsBeacon = someNodeIsBeacon || n.Beacon
+	}
+	for i, n := range s.Nodes {
+		field := fmt.Sprintf("nodes[%d]", i)
+		nodeConfig := &cfg.NodeConfigs[i]
+		nodeConfig.Name = s.nodeName(i)
+		if n.Binary != "" {
+			nodeConfig.BinaryPath = n.Binary
+		}
+		nodeConfig.IsBeacon = n.Beacon || !someNodeIsBeacon
+		nodeConfig.BootstrapNodes = n.BootstrapNodes
+		nodeConfig.Flags = n.Flags
+		nodeConfig.ConfigFile, err = mergeConfigFile(nodeConfig.ConfigFile, s.NodeConfig, n.Config)
+		if err != nil {
+			return nil, &FieldError{Field: field + ".config", Err: err}
+		}
+		for chain, chainConfig := range n.ChainConfigs {
+			chainConfigBytes, err := json.Marshal(chainConfig)
+			if err != nil {
+				return nil, &FieldError{Field: field + ".chainConfigs." + chain, Err: err}
+			}
+			if chain == "C" {
+				nodeConfig.CChainConfigFile = string(chainConfigBytes)
+				continue
+			}
+			if nodeConfig.ChainConfigFiles == nil {
+				nodeConfig.ChainConfigFiles = make(map[string]string)
+			}
+			nodeConfig.ChainConfigFiles[chain] = string(chainConfigBytes)
+		}
+		if n.StakingKey != "" {
+			stakingKey, err := os.ReadFile(n.StakingKey)
+			if err != nil {
+				return nil, &FieldError{Field: field + ".stakingKey", Err: err}
+			}
+			stakingCert, err := os.ReadFile(n.StakingCert)
+			if err != nil {
+				return nil, &FieldError{Field: field + ".stakingCert", Err: err}
+			}
+			nodeConfig.StakingKey = string(stakingKey)
+			nodeConfig.StakingCert = string(stakingCert)
+		}
+	}
+
+	switch {
+	case s.Genesis.File != "":
+		genesis, err := os.ReadFile(s.Genesis.File)
+		if err != nil {
+			return nil, &FieldError{Field: "genesis.file", Err: err}
+		}
+		cfg.Genesis = string(genesis)
+	case s.Genesis.Builder != nil:
+		cfg.Genesis, err = BuildGenesis(*s.Genesis.Builder, cfg)
+		if err != nil {
+			return nil, &FieldError{Field: "genesis.builder", Err: err}
+		}
+	}
+	networkID, err := utils.NetworkIDFromGenesis([]byte(cfg.Genesis))
+	if err != nil {
+		return nil, &FieldError{Field: "genesis", Err: err}
+	}
+	for i, nodeConfig := range cfg.NodeConfigs {
+		if err := nodeConfig.Validate(networkID); err != nil {
+			return nil, &FieldError{Field: fmt.Sprintf("nodes[%d]", i), Err: err}
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	nw := &Network{
+		Config:             cfg,
+		PluginDir:          s.PluginDir,
+		CustomVMs:          make(map[string]string, len(s.CustomVMs)),
+		WhitelistedSubnets: strings.Join(s.WhitelistedSubnets, ","),
+	}
+	for _, vm := range s.CustomVMs {
+		nw.CustomVMs[vm.Name] = vm.Genesis
+	}
+	return nw, nil
+}
+
+// BuildGenesis returns the genesis built by [builder] for the network of [cfg].
+// If the builder has no network ID, the one of the genesis of [cfg] is used,
+// and if it has no validators, all the nodes of [cfg] are.
+func BuildGenesis(builder network.GenesisBuilder, cfg network.Config) (string, error) {
+	if builder.NetworkID == 0 {
+		networkID, err := utils.NetworkIDFromGenesis([]byte(cfg.Genesis))
+		if err != nil {
+			return "", err
+		}
+		builder.NetworkID = networkID
+	}
+	if len(builder.Validators) == 0 {
+		for _, nodeConfig := range cfg.NodeConfigs {
+			nodeID, err := utils.ToNodeID([]byte(nodeConfig.StakingKey), []byte(nodeConfig.StakingCert))
+			if err != nil {
+				return "", err
+			}
+			builder.Validators = append(builder.Validators, network.GenesisValidator{
+				NodeID: nodeID.PrefixedString(constants.NodeIDPrefix),
+			})
+		}
+	}
+	genesis, err := builder.Build()
+	if err != nil {
+		return "", err
+	}
+	return string(genesis), nil
+}
+
+// mergeConfigFile returns config file [configFile]
+// with the entries of [overrides], in order
+func mergeConfigFile(configFile string, overrides ...map[string]interface{}) (string, error) {
+	configMap := make(map[string]interface{})
+	if configFile != "" {
+		if err := json.Unmarshal([]byte(configFile), &configMap); err != nil {
+			return "", fmt.Errorf("couldn't unmarshal config file: %w", err)
+		}
+	}
+	for _, override := range overrides {
+		for k, v := range override {
+			configMap[k] = v
+		}
+	}
+	configFileBytes, err := json.Marshal(configMap)
+	if err != nil {
+		return "", err
+	}
+	return string(configFileBytes), nil
+}
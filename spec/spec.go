@@ -0,0 +1,380 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package spec loads networks from spec files, which describe in YAML or
+// JSON the nodes, genesis and custom VMs of a network.
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"gopkg.in/yaml.v3"
+)
+
+// CurrentVersion is the version of the spec format read by this package
+const CurrentVersion = 1
+
+// Spec describes a network
+type Spec struct {
+	// Version of the spec format. Must be CurrentVersion.
+	Version uint32 `json:"version"`
+	// Name of the network
+	Name string `json:"name"`
+	// Log level of the nodes, e.g. INFO. Defaults to INFO.
+	LogLevel string `json:"logLevel"`
+	// Path of the avalanchego binary of the nodes that don't give theirs
+	Binary string `json:"binary"`
+	// Dir of the binaries of the custom VMs, named by VM ID.
+	// Must be given if there are custom VMs.
+	PluginDir string `json:"pluginDir"`
+	// Seed of the staking identities of the nodes past the default ones.
+	// Defaults to local.DefaultIdentitySeed.
+	IdentitySeed string `json:"identitySeed"`
+	// If true, nodes reach each other through proxies,
+	// so the network can be partitioned
+	P2PProxy bool `json:"p2pProxy"`
+	// If true, each node gets its own loopback IP.
+	// See network.Config.DistinctNodeIPs.
+	DistinctNodeIPs bool `json:"distinctNodeIPs"`
+	// Flags of all the nodes. See network.Config.Flags.
+	Flags map[string]interface{} `json:"flags"`
+	// Config file entries of all the nodes, overridden by their own
+	NodeConfig map[string]interface{} `json:"nodeConfig"`
+	Genesis    Genesis                `json:"genesis"`
+	// Must not be empty
+	Nodes []Node `json:"nodes"`
+	// VMs installed on new subnets once the nodes are healthy
+	CustomVMs []CustomVM `json:"customVMs"`
+	// IDs of the subnets the nodes validate, besides the ones
+	// of the custom VMs
+	WhitelistedSubnets []string `json:"whitelistedSubnets"`
+}
+
+// Genesis is the genesis of a network. If no file or builder is
+// given, the default genesis is used.
+type Genesis struct {
+	// Path of the genesis file
+	File string `json:"file"`
+	// Builder of the genesis. If it has no network ID, the one of the
+	// default genesis is used, and if it has no validators, all nodes are.
+	Builder *network.GenesisBuilder `json:"builder"`
+}
+
+// Node is a node of a network
+type Node struct {
+	// Must be unique. Defaults to node<position of the node, from 1>.
+	Name string `json:"name"`
+	// Path of the avalanchego binary. Defaults to the one of the spec.
+	Binary string `json:"binary"`
+	// True if other nodes bootstrap from this node.
+	// If no node is a beacon, all nodes are.
+	Beacon bool `json:"beacon"`
+	// Names of the nodes this node bootstraps from,
+	// instead of the beacons. Must be in the spec.
+	BootstrapNodes []string `json:"bootstrapNodes"`
+	// Flags of the node, overriding the ones of the spec
+	Flags map[string]interface{} `json:"flags"`
+	// Config file entries, overriding the node config of the spec
+	Config map[string]interface{} `json:"config"`
+	// Configs of the chains, by chain alias or ID, e.g. C
+	ChainConfigs map[string]map[string]interface{} `json:"chainConfigs"`
+	// Paths of the staking key and cert of the node. If not given, the
+	// node gets the staking identity of its position in the network.
+	// See local.NewDefaultConfigNNodesFromSeed.
+	StakingKey  string `json:"stakingKey"`
+	StakingCert string `json:"stakingCert"`
+}
+
+// CustomVM is a VM installed on a new subnet of a network
+type CustomVM struct {
+	// Name of the VM, whose ID is the name padded to 32 bytes
+	Name string `json:"name"`
+	// Path of the genesis of the blockchain of the VM
+	Genesis string `json:"genesis"`
+}
+
+// FieldError is an error in a field of a spec
+type FieldError struct {
+	// Path of the field, e.g. nodes[1].binary
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	if e.Field == "" {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s: %s", e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+func fieldErrorf(field string, format string, args ...interface{}) error {
+	return &FieldError{Field: field, Err: fmt.Errorf(format, args...)}
+}
+
+// Load returns the spec of the YAML or JSON file at [path].
+// Its relative paths are made relative to the dir of the file.
+func Load(path string) (*Spec, error) {
+	specBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s, err := Parse(specBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid spec %q: %w", path, err)
+	}
+	s.resolvePaths(filepath.Dir(path))
+	return s, nil
+}
+
+// Parse returns the spec of YAML or JSON [specBytes], or an error if it's
+// invalid. Unknown fields are errors, so that typos aren't ignored.
+func Parse(specBytes []byte) (*Spec, error) {
+	// JSON is YAML, so both go through YAML, then JSON to get the
+	// unmarshaling of the types of the network package
+	var specMap interface{}
+	if err := yaml.Unmarshal(specBytes, &specMap); err != nil {
+		return nil, fmt.Errorf("couldn't unmarshal spec: %w", err)
+	}
+	if err := checkValue("", specMap, reflect.TypeOf(Spec{})); err != nil {
+		return nil, err
+	}
+	specJSON, err := json.Marshal(specMap)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't convert spec to JSON: %w", err)
+	}
+	s := &Spec{}
+	decoder := json.NewDecoder(bytes.NewReader(specJSON))
+	if err := decoder.Decode(s); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return nil, fieldErrorf(typeErr.Field, "expected %s, got %s", typeErr.Type, typeErr.Value)
+		}
+		return nil, err
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// checkValue returns an error naming the first field of [value], a YAML
+// value at [path], that type [t] doesn't have or that has the wrong type
+func checkValue(path string, value interface{}, t reflect.Type) error {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if value == nil || reflect.PtrTo(t).Implements(unmarshalerType) {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		if _, ok := value.(bool); !ok {
+			return fieldErrorf(path, "expected a bool, got %v", value)
+		}
+	case reflect.String:
+		if _, ok := value.(string); !ok {
+			return fieldErrorf(path, "expected a string, got %v", value)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, ok := value.(int)
+		if !ok || reflect.Zero(t).OverflowInt(int64(i)) {
+			return fieldErrorf(path, "expected a %s, got %v", t.Kind(), value)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var u uint64
+		switch v := value.(type) {
+		case int:
+			if v < 0 {
+				return fieldErrorf(path, "expected a %s, got %v", t.Kind(), value)
+			}
+			u = uint64(v)
+		case uint64:
+			u = v
+		default:
+			return fieldErrorf(path, "expected a %s, got %v", t.Kind(), value)
+		}
+		if reflect.Zero(t).OverflowUint(u) {
+			return fieldErrorf(path, "expected a %s, got %v", t.Kind(), value)
+		}
+	case reflect.Struct:
+		valueMap, ok := value.(map[string]interface{})
+		if !ok {
+			return fieldErrorf(path, "expected a mapping, got %v", value)
+		}
+		fields := make(map[string]reflect.Type, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if name == "" {
+				name = field.Name
+			}
+			fields[name] = field.Type
+		}
+		// Keys are sorted so that the same error is returned on each load
+		keys := make([]string, 0, len(valueMap))
+		for key := range valueMap {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fieldPath := joinPath(path, key)
+			fieldType, ok := fields[key]
+			if !ok {
+				return fieldErrorf(fieldPath, "unknown field")
+			}
+			if err := checkValue(fieldPath, valueMap[key], fieldType); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		values, ok := value.([]interface{})
+		if !ok {
+			return fieldErrorf(path, "expected a list, got %v", value)
+		}
+		for i, elem := range values {
+			if err := checkValue(fmt.Sprintf("%s[%d]", path, i), elem, t.Elem()); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		valueMap, ok := value.(map[string]interface{})
+		if !ok {
+			return fieldErrorf(path, "expected a mapping, got %v", value)
+		}
+		for key, elem := range valueMap {
+			if err := checkValue(joinPath(path, key), elem, t.Elem()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func joinPath(path string, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+// Validate returns an error, naming the field at fault, if this spec is invalid
+func (s *Spec) Validate() error {
+	switch s.Version {
+	case 0:
+		return fieldErrorf("version", "not given")
+	case CurrentVersion:
+	default:
+		return fieldErrorf("version", "unsupported version %d, expected %d", s.Version, CurrentVersion)
+	}
+	if s.LogLevel != "" {
+		if _, err := logging.ToLevel(s.LogLevel); err != nil {
+			return &FieldError{Field: "logLevel", Err: err}
+		}
+	}
+	if s.Genesis.File != "" && s.Genesis.Builder != nil {
+		return fieldErrorf("genesis", "both file and builder given")
+	}
+
+	if len(s.Nodes) == 0 {
+		return fieldErrorf("nodes", "no nodes given")
+	}
+	names := make(map[string]struct{}, len(s.Nodes))
+	for i, n := range s.Nodes {
+		field := fmt.Sprintf("nodes[%d]", i)
+		name := s.nodeName(i)
+		if _, ok := names[name]; ok {
+			return fieldErrorf(field+".name", "duplicate node name %q", name)
+		}
+		names[name] = struct{}{}
+		if n.Binary == "" && s.Binary == "" {
+			return fieldErrorf(field+".binary", "not given, and the spec has no binary")
+		}
+		if (n.StakingKey == "") != (n.StakingCert == "") {
+			return fieldErrorf(field, "staking key and cert must be given together")
+		}
+	}
+	for i, n := range s.Nodes {
+		for j, bootstrapNode := range n.BootstrapNodes {
+			field := fmt.Sprintf("nodes[%d].bootstrapNodes[%d]", i, j)
+			if _, ok := names[bootstrapNode]; !ok {
+				return fieldErrorf(field, "node %q not in the spec", bootstrapNode)
+			}
+			if bootstrapNode == s.nodeName(i) {
+				return fieldErrorf(field, "node can't bootstrap from itself")
+			}
+		}
+	}
+
+	if len(s.CustomVMs) > 0 && s.PluginDir == "" {
+		return fieldErrorf("pluginDir", "not given, but custom VMs are")
+	}
+	vmNames := make(map[string]struct{}, len(s.CustomVMs))
+	for i, vm := range s.CustomVMs {
+		field := fmt.Sprintf("customVMs[%d]", i)
+		if vm.Name == "" {
+			return fieldErrorf(field+".name", "not given")
+		}
+		if _, err := utils.VMID(vm.Name); err != nil {
+			return &FieldError{Field: field + ".name", Err: err}
+		}
+		if _, ok := vmNames[vm.Name]; ok {
+			return fieldErrorf(field+".name", "duplicate VM name %q", vm.Name)
+		}
+		vmNames[vm.Name] = struct{}{}
+		if vm.Genesis == "" {
+			return fieldErrorf(field+".genesis", "not given")
+		}
+	}
+	for i, subnetID := range s.WhitelistedSubnets {
+		if _, err := ids.FromString(subnetID); err != nil {
+			return &FieldError{Field: fmt.Sprintf("whitelistedSubnets[%d]", i), Err: err}
+		}
+	}
+	return nil
+}
+
+// nodeName returns the name of the [i]th node
+func (s *Spec) nodeName(i int) string {
+	if s.Nodes[i].Name != "" {
+		return s.Nodes[i].Name
+	}
+	return fmt.Sprintf("node%d", i+1)
+}
+
+// resolvePaths makes the relative paths of this spec relative to [dir]
+func (s *Spec) resolvePaths(dir string) {
+	resolve := func(path *string) {
+		if *path != "" && !filepath.IsAbs(*path) {
+			*path = filepath.Join(dir, *path)
+		}
+	}
+	resolve(&s.Binary)
+	resolve(&s.PluginDir)
+	resolve(&s.Genesis.File)
+	for i := range s.Nodes {
+		resolve(&s.Nodes[i].Binary)
+		resolve(&s.Nodes[i].StakingKey)
+		resolve(&s.Nodes[i].StakingCert)
+	}
+	for i := range s.CustomVMs {
+		resolve(&s.CustomVMs[i].Genesis)
+	}
+}
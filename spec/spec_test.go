@@ -0,0 +1,142 @@
+package spec_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/spec"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSpec = `
+version: 1
+name: test
+logLevel: debug
+binary: bin/avalanchego
+pluginDir: plugins
+flags:
+  log-display-level: INFO
+nodeConfig:
+  api-admin-enabled: false
+genesis:
+  builder:
+    message: spec
+nodes:
+  - name: bootstrapper
+    beacon: true
+    config:
+      index-enabled: false
+    chainConfigs:
+      C:
+        eth-apis: [eth]
+      X:
+        index-transactions: true
+  - binary: /usr/bin/avalanchego
+    flags:
+      log-level: WARN
+    bootstrapNodes: [bootstrapper]
+customVMs:
+  - name: subnetevm
+    genesis: subnetevm.json
+whitelistedSubnets: [p433wpuXyJiDhyazPYyZMJeaoPSW76CBZ2x7wrVPLgvokotXz]
+`
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "spec.yaml")
+	assert.NoError(os.WriteFile(specPath, []byte(testSpec), 0o600))
+
+	// Relative paths are relative to the spec
+	s, err := spec.Load(specPath)
+	assert.NoError(err)
+	assert.EqualValues(spec.CurrentVersion, s.Version)
+	assert.Equal(filepath.Join(dir, "bin/avalanchego"), s.Binary)
+	assert.Equal(filepath.Join(dir, "plugins"), s.PluginDir)
+	assert.Equal(filepath.Join(dir, "subnetevm.json"), s.CustomVMs[0].Genesis)
+	assert.Equal("/usr/bin/avalanchego", s.Nodes[1].Binary)
+
+	nw, err := s.Build()
+	assert.NoError(err)
+	cfg := nw.Config
+	assert.Equal("test", cfg.Name)
+	assert.Equal("DEBUG", cfg.LogLevel)
+	assert.Equal("INFO", cfg.Flags["log-display-level"])
+	assert.Len(cfg.NodeConfigs, 2)
+	bootstrapper, node2 := cfg.NodeConfigs[0], cfg.NodeConfigs[1]
+	assert.Equal("bootstrapper", bootstrapper.Name)
+	assert.True(bootstrapper.IsBeacon)
+	assert.Equal(s.Binary, bootstrapper.BinaryPath)
+	var configFile map[string]interface{}
+	assert.NoError(json.Unmarshal([]byte(bootstrapper.ConfigFile), &configFile))
+	assert.Equal(false, configFile["api-admin-enabled"])
+	assert.Equal(false, configFile["index-enabled"])
+	assert.JSONEq(`{"eth-apis":["eth"]}`, bootstrapper.CChainConfigFile)
+	assert.JSONEq(`{"index-transactions":true}`, bootstrapper.ChainConfigFiles["X"])
+	assert.Equal("node2", node2.Name)
+	assert.False(node2.IsBeacon)
+	assert.Equal([]string{"bootstrapper"}, node2.BootstrapNodes)
+	assert.Equal("/usr/bin/avalanchego", node2.BinaryPath)
+	assert.Equal("WARN", node2.Flags["log-level"])
+	assert.NoError(json.Unmarshal([]byte(node2.ConfigFile), &configFile))
+	assert.Equal(false, configFile["api-admin-enabled"])
+	assert.Equal(true, configFile["index-enabled"])
+	networkID, err := utils.NetworkIDFromGenesis([]byte(cfg.Genesis))
+	assert.NoError(err)
+	assert.EqualValues(1337, networkID)
+	assert.Contains(cfg.Genesis, `"message":"spec"`)
+	assert.Equal(map[string]string{"subnetevm": s.CustomVMs[0].Genesis}, nw.CustomVMs)
+	assert.Equal("p433wpuXyJiDhyazPYyZMJeaoPSW76CBZ2x7wrVPLgvokotXz", nw.WhitelistedSubnets)
+
+	// The JSON of a spec is a spec
+	specJSON, err := json.Marshal(s)
+	assert.NoError(err)
+	s2, err := spec.Parse(specJSON)
+	assert.NoError(err)
+	assert.Equal(s, s2)
+
+	// Files of the spec are read on build
+	s.Genesis.Builder = nil
+	s.Genesis.File = filepath.Join(dir, "genesis.json")
+	_, err = s.Build()
+	var fieldErr *spec.FieldError
+	assert.True(errors.As(err, &fieldErr))
+	assert.Equal("genesis.file", fieldErr.Field)
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+
+	for spec_, field := range map[string]string{
+		"nodes: [{binary: b}]":                                                                "version",
+		"version: 2\nnodes: [{binary: b}]":                                                    "version",
+		"version: 1":                                                                          "nodes",
+		"version: 1\nnodes: {binary: b}":                                                      "nodes",
+		"version: one\nnodes: [{binary: b}]":                                                  "version",
+		"version: 1\nnodes: [{binary: b}, {binray: b}]":                                       "nodes[1].binray",
+		"version: 1\nnodes: [{binary: b, beacon: yes}]":                                       "nodes[0].beacon",
+		"version: 1\nnodes: [{name: a, binary: b}, {name: a}]":                                "nodes[1].name",
+		"version: 1\nnodes: [{}]":                                                             "nodes[0].binary",
+		"version: 1\nbinary: b\nnodes: [{stakingKey: k}]":                                     "nodes[0]",
+		"version: 1\nbinary: b\nlogLevel: loud\nnodes: [{}]":                                  "logLevel",
+		"version: 1\nbinary: b\nnodes: [{}]\ngenesis: {file: g, builder: {}}":                 "genesis",
+		"version: 1\nbinary: b\nnodes: [{}]\ngenesis: {builder: {validators: [{nodeId: n}]}}": "genesis.builder.validators[0].nodeId",
+		"version: 1\nbinary: b\nnodes: [{}]\ncustomVMs: [{name: vm, genesis: g}]":             "pluginDir",
+		"version: 1\nbinary: b\nnodes: [{}]\npluginDir: p\ncustomVMs: [{genesis: g}]":         "customVMs[0].name",
+		"version: 1\nbinary: b\nnodes: [{}]\nwhitelistedSubnets: [subnet]":                    "whitelistedSubnets[0]",
+		"version: 1\nbinary: b\nnodes: [{}, {bootstrapNodes: [node3]}]":                       "nodes[1].bootstrapNodes[0]",
+		"version: 1\nbinary: b\nnodes: [{bootstrapNodes: [node1]}]":                           "nodes[0].bootstrapNodes[0]",
+	} {
+		_, err := spec.Parse([]byte(spec_))
+		var fieldErr *spec.FieldError
+		if assert.True(errors.As(err, &fieldErr), spec_) {
+			assert.Equal(field, fieldErr.Field, spec_)
+		}
+	}
+}